@@ -4,9 +4,14 @@ import (
 	"context"
 )
 
-// BlockClient provides operations on blocks within an object
+// BlockClient provides operations on blocks within an object.
+//
+// The API has no block endpoints, so blocks are parsed from the markdown body
+// of the object and are read-only. Editing them would mean rewriting the body,
+// which the API only accepts when creating the object, so Create, Update and
+// Delete return an error wrapping ErrUnsupported.
 type BlockClient interface {
-	// List returns all blocks in the object
+	// List returns all blocks in the object in document order
 	List(ctx context.Context) ([]Block, error)
 
 	// Get retrieves a specific block by ID
@@ -39,8 +44,23 @@ type Text struct {
 	Content  string
 	Style    string
 	Markdown string
+	Checked  bool `json:"checked,omitempty"`
 }
 
+// Text block styles
+const (
+	TextStyleParagraph = "Paragraph"
+	TextStyleHeader1   = "Header1"
+	TextStyleHeader2   = "Header2"
+	TextStyleHeader3   = "Header3"
+	TextStyleHeader4   = "Header4"
+	TextStyleQuote     = "Quote"
+	TextStyleCode      = "Code"
+	TextStyleCheckbox  = "Checkbox"
+	TextStyleMarked    = "Marked"
+	TextStyleNumbered  = "Numbered"
+)
+
 // File represents a file reference
 type File struct {
 	Name           string
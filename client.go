@@ -1,9 +1,37 @@
 package anytype
 
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
 // ClientOptions contains configuration options for the Anytype client
 type ClientOptions struct {
 	BaseURL string
 	AppKey  string
+
+	// HTTPClient is the HTTP client used to send requests (defaults to http.DefaultClient)
+	HTTPClient *http.Client
+	// Middleware is applied to every request, in order, inside the retry middleware
+	Middleware []func(middleware.HTTPDoer) middleware.HTTPDoer
+	// RetryConfig overrides the default retry configuration
+	RetryConfig *middleware.RetryConfig
+	// DisableRetry disables the retry middleware
+	DisableRetry bool
+	// DisableValidation sends requests without validating them first
+	DisableValidation bool
+	// Logging enables structured logging of requests, inside the retry
+	// middleware so that every attempt is logged
+	Logging *middleware.LoggingConfig
+	// RateLimit enables client-side rate limiting, inside the retry middleware
+	// so that retries are throttled too
+	RateLimit *middleware.RateLimitConfig
+	// Disconnect enables the circuit breaker of the disconnect middleware,
+	// inside the logging middleware, whose state is reported by
+	// CircuitReporter
+	Disconnect *middleware.DisconnectConfig
 }
 
 // Client is the main interface for interacting with the Anytype API
@@ -21,6 +49,15 @@ type Client interface {
 	Search() SearchClient
 }
 
+// CircuitReporter is implemented by the clients returned by NewClient, to
+// report the state of the circuit breaker enabled with WithDisconnect
+type CircuitReporter interface {
+	// CircuitState returns the state of the circuit breaker, for health
+	// checks. It is always CircuitClosed when the circuit breaker is not
+	// enabled.
+	CircuitState() middleware.CircuitState
+}
+
 // clientConstructor is a function type that constructs a Client
 type clientConstructor func(ClientOptions) Client
 
@@ -49,6 +86,85 @@ func WithAppKey(appKey string) ClientOption {
 	}
 }
 
+// WithHTTPClient sets the HTTP client used to send requests
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *ClientOptions) {
+		o.HTTPClient = httpClient
+	}
+}
+
+// WithMiddleware appends middleware to the client's middleware chain.
+// Middleware runs inside the retry middleware, so it sees every attempt.
+func WithMiddleware(mw ...func(middleware.HTTPDoer) middleware.HTTPDoer) ClientOption {
+	return func(o *ClientOptions) {
+		o.Middleware = append(o.Middleware, mw...)
+	}
+}
+
+// WithRetryConfig sets a custom retry configuration
+func WithRetryConfig(config middleware.RetryConfig) ClientOption {
+	return func(o *ClientOptions) {
+		o.RetryConfig = &config
+		o.DisableRetry = false
+	}
+}
+
+// WithoutRetry disables retrying failed requests
+func WithoutRetry() ClientOption {
+	return func(o *ClientOptions) {
+		o.DisableRetry = true
+	}
+}
+
+// WithoutValidation sends requests without validating them first, leaving
+// validation to the API
+func WithoutValidation() ClientOption {
+	return func(o *ClientOptions) {
+		o.DisableValidation = true
+	}
+}
+
+// WithLogger logs every request to logger with the default logging
+// configuration. Deprecation warnings of the client go to logger too.
+func WithLogger(logger *slog.Logger) ClientOption {
+	config := middleware.DefaultLoggingConfig()
+	config.Logger = logger
+	return WithLoggingConfig(config)
+}
+
+// WithLoggingConfig sets a custom logging configuration
+func WithLoggingConfig(config middleware.LoggingConfig) ClientOption {
+	return func(o *ClientOptions) {
+		o.Logging = &config
+	}
+}
+
+// WithRateLimit throttles requests to requestsPerSecond, allowing bursts of
+// burst requests, and pauses them when the server asks to with Retry-After.
+// The budget is shared by all the goroutines using the client.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	config := middleware.DefaultRateLimitConfig()
+	config.RequestsPerSecond = requestsPerSecond
+	config.Burst = burst
+	return WithRateLimitConfig(config)
+}
+
+// WithRateLimitConfig sets a custom rate limit configuration
+func WithRateLimitConfig(config middleware.RateLimitConfig) ClientOption {
+	return func(o *ClientOptions) {
+		o.RateLimit = &config
+	}
+}
+
+// WithDisconnect handles disconnections from the server with a circuit
+// breaker: requests fail fast with an error matching middleware.ErrCircuitOpen
+// while it is open. Its state is reported by CircuitReporter.
+func WithDisconnect(config middleware.DisconnectConfig) ClientOption {
+	return func(o *ClientOptions) {
+		o.Disconnect = &config
+	}
+}
+
 // NewClient creates a new Anytype API client with the given options
 func NewClient(opts ...ClientOption) Client {
 	if defaultClientConstructor == nil {
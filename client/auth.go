@@ -3,7 +3,6 @@ package client
 
 import (
 	"context"
-	"log"
 
 	"github.com/rubiojr/anytype-go"
 )
@@ -70,7 +69,7 @@ func (ac *AuthClientImpl) CreateApiKey(ctx context.Context, challengeID string,
 // DisplayCode initiates a secure authentication flow
 // Deprecated: Use CreateChallenge instead
 func (ac *AuthClientImpl) DisplayCode(ctx context.Context, appName string) (*anytype.DisplayCodeResponse, error) {
-	log.Println("Warning: DisplayCode is deprecated, use CreateChallenge instead")
+	ac.client.logger.WarnContext(ctx, "DisplayCode is deprecated, use CreateChallenge instead")
 
 	resp, err := ac.CreateChallenge(ctx, appName)
 	if err != nil {
@@ -85,7 +84,7 @@ func (ac *AuthClientImpl) DisplayCode(ctx context.Context, appName string) (*any
 // GetToken completes the authentication flow by providing a code
 // Deprecated: Use CreateApiKey instead
 func (ac *AuthClientImpl) GetToken(ctx context.Context, challengeID string, code string) (*anytype.TokenResponse, error) {
-	log.Println("Warning: GetToken is deprecated, use CreateApiKey instead")
+	ac.client.logger.WarnContext(ctx, "GetToken is deprecated, use CreateApiKey instead")
 
 	resp, err := ac.CreateApiKey(ctx, challengeID, code)
 	if err != nil {
@@ -2,48 +2,67 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/rubiojr/anytype-go"
 )
 
-// BlockClientImpl implements the BlockClient interface
+// BlockClientImpl implements the BlockClient interface on top of the
+// markdown body of the object, as the API has no block endpoints. Writes are
+// unsupported since the body cannot be updated through the API.
 type BlockClientImpl struct {
 	client   *ClientImpl
 	spaceID  string
 	objectID string
 }
 
-// List returns all blocks in the object
+// List returns all blocks in the object in document order
 func (bc *BlockClientImpl) List(ctx context.Context) ([]anytype.Block, error) {
-	// Actual implementation would make an HTTP request to the endpoint
-	// GET /spaces/{space_id}/objects/{object_id}/blocks
-	return nil, nil
+	object := &ObjectContextImpl{
+		client:   bc.client,
+		spaceID:  bc.spaceID,
+		objectID: bc.objectID,
+	}
+
+	resp, err := object.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Object == nil {
+		return nil, nil
+	}
+
+	return anytype.BlocksFromMarkdown(resp.Object.Markdown), nil
 }
 
 // Get retrieves a specific block by ID
 func (bc *BlockClientImpl) Get(ctx context.Context, blockID string) (*anytype.Block, error) {
-	// Actual implementation would make an HTTP request to the endpoint
-	// GET /spaces/{space_id}/objects/{object_id}/blocks/{block_id}
-	return nil, nil
+	blocks, err := bc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range blocks {
+		if block.ID == blockID {
+			return &block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("block %q: %w", blockID, anytype.ErrNotFound)
 }
 
 // Create creates a new block in the object
 func (bc *BlockClientImpl) Create(ctx context.Context, request anytype.CreateBlockRequest) (*anytype.Block, error) {
-	// Actual implementation would make an HTTP request to the endpoint
-	// POST /spaces/{space_id}/objects/{object_id}/blocks
-	return nil, nil
+	return nil, fmt.Errorf("creating blocks: %w", anytype.ErrUnsupported)
 }
 
 // Update updates a block in the object
 func (bc *BlockClientImpl) Update(ctx context.Context, blockID string, request anytype.UpdateBlockRequest) error {
-	// Actual implementation would make an HTTP request to the endpoint
-	// PUT /spaces/{space_id}/objects/{object_id}/blocks/{block_id}
-	return nil
+	return fmt.Errorf("updating blocks: %w", anytype.ErrUnsupported)
 }
 
 // Delete deletes a block from the object
 func (bc *BlockClientImpl) Delete(ctx context.Context, blockID string) error {
-	// Actual implementation would make an HTTP request to the endpoint
-	// DELETE /spaces/{space_id}/objects/{object_id}/blocks/{block_id}
-	return nil
+	return fmt.Errorf("deleting blocks: %w", anytype.ErrUnsupported)
 }
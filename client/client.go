@@ -1,16 +1,20 @@
 package client
 
 import (
+	"log/slog"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/middleware"
 )
 
 // ClientImpl is the actual implementation of the Client interface
 type ClientImpl struct {
-	httpClient *http.Client
+	httpClient middleware.HTTPDoer
 	baseURL    string
 	appKey     string
+	logger     *slog.Logger
+	disconnect *middleware.DisconnectMiddleware
 }
 
 func init() {
@@ -20,13 +24,70 @@ func init() {
 
 // NewClient creates a new Anytype API client with the given options
 func NewClient(options anytype.ClientOptions) anytype.Client {
+	httpClient, disconnect := buildChain(options)
 	return &ClientImpl{
-		httpClient: http.DefaultClient,
+		httpClient: httpClient,
 		baseURL:    options.BaseURL,
 		appKey:     options.AppKey,
+		logger:     clientLogger(options),
+		disconnect: disconnect,
 	}
 }
 
+// clientLogger returns the logger of the client's own messages
+func clientLogger(options anytype.ClientOptions) *slog.Logger {
+	if options.Logging != nil && options.Logging.Logger != nil {
+		return options.Logging.Logger
+	}
+	return slog.Default()
+}
+
+// buildChain builds the middleware chain used for all requests of a client,
+// returning its disconnect middleware when enabled
+func buildChain(options anytype.ClientOptions) (middleware.HTTPDoer, *middleware.DisconnectMiddleware) {
+	httpClient := http.DefaultClient
+	if options.HTTPClient != nil {
+		httpClient = options.HTTPClient
+	}
+
+	chain := middleware.NewChain(httpClient)
+
+	// Invalid requests fail before being retried or sent
+	if !options.DisableValidation {
+		chain.Use(middleware.WithValidation())
+	}
+
+	if !options.DisableRetry {
+		retryConfig := middleware.DefaultRetryConfig()
+		if options.RetryConfig != nil {
+			retryConfig = *options.RetryConfig
+		}
+		chain.Use(middleware.WithCustomRetry(retryConfig))
+	}
+
+	if options.RateLimit != nil {
+		chain.Use(middleware.WithRateLimit(*options.RateLimit))
+	}
+
+	if options.Logging != nil {
+		chain.Use(middleware.WithLogging(*options.Logging))
+	}
+
+	var disconnect *middleware.DisconnectMiddleware
+	if options.Disconnect != nil {
+		chain.Use(func(next middleware.HTTPDoer) middleware.HTTPDoer {
+			disconnect = middleware.NewDisconnectMiddleware(next, *options.Disconnect)
+			return disconnect
+		})
+	}
+
+	for _, mw := range options.Middleware {
+		chain.Use(mw)
+	}
+
+	return chain.Build(), disconnect
+}
+
 // Spaces returns a SpaceClient for working with spaces
 func (c *ClientImpl) Spaces() anytype.SpaceClient {
 	return &SpaceClientImpl{client: c}
@@ -49,3 +110,12 @@ func (c *ClientImpl) Search() anytype.SearchClient {
 func (c *ClientImpl) Auth() anytype.AuthClient {
 	return &AuthClientImpl{client: c}
 }
+
+// CircuitState returns the state of the circuit breaker, CircuitClosed when
+// it is not enabled
+func (c *ClientImpl) CircuitState() middleware.CircuitState {
+	if c.disconnect == nil {
+		return middleware.CircuitClosed
+	}
+	return c.disconnect.State()
+}
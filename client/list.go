@@ -3,9 +3,11 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // ListClientImpl implements the ListClient interface
@@ -189,6 +191,24 @@ func (vc *ViewClientImpl) List(ctx context.Context) (*anytype.ViewListResponse,
 	return response, nil
 }
 
+// All returns an iterator over all views for the list, fetching pages lazily
+func (vc *ViewClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.ListView, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.ListView, options.PaginationMetadata, error) {
+		endpoint := "/spaces/" + vc.spaceID + "/lists/" + vc.listID + "/views"
+		req, err := vc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		response := &anytype.ViewListResponse{}
+		if err := vc.client.doRequest(req, response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
 // ViewContextImpl implements the ViewContext interface
 type ViewContextImpl struct {
 	client  *ClientImpl
@@ -291,3 +311,21 @@ func (ovc *ObjectViewClientImpl) List(ctx context.Context) (*anytype.ObjectListR
 
 	return response, nil
 }
+
+// All returns an iterator over all objects in the view, fetching pages lazily
+func (ovc *ObjectViewClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Object, options.PaginationMetadata, error) {
+		endpoint := "/spaces/" + ovc.spaceID + "/lists/" + ovc.listID + "/views/" + ovc.viewID + "/objects"
+		req, err := ovc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		response := &anytype.ObjectListResponse{}
+		if err := ovc.client.doRequest(req, response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
@@ -33,10 +34,30 @@ func (mc *MemberClientImpl) List(ctx context.Context) (*anytype.MemberListRespon
 	}
 
 	return &anytype.MemberListResponse{
-		Data: response.Data,
+		Data:       response.Data,
+		Pagination: response.Pagination,
 	}, nil
 }
 
+// All returns an iterator over all members of the space, fetching pages lazily
+func (mc *MemberClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Member, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Member, options.PaginationMetadata, error) {
+		path := "/spaces/" + mc.spaceID + "/members"
+
+		req, err := mc.client.newRequest(ctx, http.MethodGet, paginatedPath(path, limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		var response anytype.MemberListResponse
+		if err := mc.client.doRequest(req, &response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
 // MemberContextImpl implements the MemberContext interface
 type MemberContextImpl struct {
 	client   *ClientImpl
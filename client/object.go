@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
@@ -17,24 +18,25 @@ type ObjectClientImpl struct {
 
 // List returns all objects in the space
 func (oc *ObjectClientImpl) List(ctx context.Context, opts ...options.ListOption) ([]anytype.Object, error) {
-	endpoint := fmt.Sprintf("/spaces/%s/objects", oc.spaceID)
-
 	// Apply all list options to create query parameters
 	listOpts := options.ApplyListOptions(opts...)
-	if listOpts.Limit > 0 {
-		endpoint = fmt.Sprintf("%s?limit=%d", endpoint, listOpts.Limit)
-	}
-	if listOpts.Offset > 0 {
-		if listOpts.Limit > 0 {
-			endpoint = fmt.Sprintf("%s&offset=%d", endpoint, listOpts.Offset)
-		} else {
-			endpoint = fmt.Sprintf("%s?offset=%d", endpoint, listOpts.Offset)
-		}
-	}
 
-	req, err := oc.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	objects, _, err := oc.listPage(ctx, listOpts.Limit, listOpts.Offset)
+	return objects, err
+}
+
+// All returns an iterator over all objects in the space, fetching pages lazily
+func (oc *ObjectClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	return paginate(ctx, oc.listPage, opts...)
+}
+
+// listPage fetches a single page of objects in the space
+func (oc *ObjectClientImpl) listPage(ctx context.Context, limit, offset int) ([]anytype.Object, options.PaginationMetadata, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/objects", oc.spaceID)
+
+	req, err := oc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
 	if err != nil {
-		return nil, err
+		return nil, options.PaginationMetadata{}, err
 	}
 
 	var response struct {
@@ -44,10 +46,10 @@ func (oc *ObjectClientImpl) List(ctx context.Context, opts ...options.ListOption
 
 	err = oc.client.doRequest(req, &response)
 	if err != nil {
-		return nil, err
+		return nil, options.PaginationMetadata{}, err
 	}
 
-	return response.Data, nil
+	return response.Data, response.Pagination, nil
 }
 
 // Create creates a new object in the space
@@ -68,6 +70,64 @@ func (oc *ObjectClientImpl) Create(ctx context.Context, request anytype.CreateOb
 	return &response, nil
 }
 
+// Upsert updates the object of the request type matching the key, or
+// creates it when none matches. The API cannot update the body of an
+// existing object, so updates of requests with a body are rejected.
+func (oc *ObjectClientImpl) Upsert(ctx context.Context, key anytype.UpsertKey, request anytype.CreateObjectRequest) (*anytype.UpsertResponse, error) {
+	value, err := key.Value(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Narrow the search down by name when possible, then compare exactly
+	search := anytype.SearchRequest{Types: []string{request.TypeKey}}
+	if key.PropertyKey == "" {
+		search.Query = request.Name
+	}
+
+	space := &SpaceContextImpl{client: oc.client, spaceID: oc.spaceID}
+	var matches []string
+	for obj, err := range space.SearchAll(ctx, search) {
+		if err != nil {
+			return nil, err
+		}
+		if key.Matches(obj, value) {
+			matches = append(matches, obj.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp, err := oc.Create(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return &anytype.UpsertResponse{Object: resp.Object, Created: true}, nil
+	case 1:
+		if request.Body != "" {
+			return nil, fmt.Errorf("upsert by %s %s: updating the body of object %s: %w", key, value, matches[0], anytype.ErrUnsupported)
+		}
+
+		object := space.Object(matches[0])
+		err := object.Update(ctx, anytype.UpdateObjectRequest{
+			Name:       request.Name,
+			Icon:       request.Icon,
+			Properties: request.Properties,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := object.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &anytype.UpsertResponse{Object: resp.Object}, nil
+	default:
+		return nil, &anytype.UpsertConflictError{Key: key, Value: value, ObjectIDs: matches}
+	}
+}
+
 // ObjectContextImpl implements the ObjectContext interface
 type ObjectContextImpl struct {
 	client   *ClientImpl
@@ -154,13 +214,11 @@ func (oc *ObjectContextImpl) UpdateIcon(ctx context.Context, icon *anytype.Icon)
 }
 
 // Export exports the object in the specified format
-func (oc *ObjectContextImpl) Export(ctx context.Context, format string) (*anytype.ExportResult, error) {
-	// Export by fetching the object and returning its Markdown field
+func (oc *ObjectContextImpl) Export(ctx context.Context, format anytype.ExportFormat) (*anytype.ExportResult, error) {
+	// Export by fetching the object and rendering it locally
 	resp, err := oc.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &anytype.ExportResult{
-		Markdown: resp.Object.Markdown,
-	}, nil
+	return anytype.ExportObject(resp.Object, format)
 }
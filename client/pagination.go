@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strings"
+
+	"github.com/rubiojr/anytype-go/options"
+)
+
+// defaultPageSize is the number of items fetched per page by iterators
+// when no limit is given, matching the API default
+const defaultPageSize = 100
+
+// pageFetcher fetches a single page of items at the given limit and offset
+type pageFetcher[T any] func(ctx context.Context, limit, offset int) ([]T, options.PaginationMetadata, error)
+
+// paginatedPath appends the limit and offset query parameters to an endpoint,
+// omitting parameters that are not set
+func paginatedPath(endpoint string, limit, offset int) string {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", limit))
+	}
+	if offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", offset))
+	}
+	if len(query) == 0 {
+		return endpoint
+	}
+
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return endpoint + separator + query.Encode()
+}
+
+// paginate returns an iterator that lazily fetches pages until the API reports
+// there are no more items. The limit option sets the page size and the offset
+// option sets where iteration starts. Iteration stops with an error if the
+// context is canceled or a page fails to load.
+func paginate[T any](ctx context.Context, fetch pageFetcher[T], opts ...options.ListOption) iter.Seq2[T, error] {
+	listOpts := options.ApplyListOptions(opts...)
+	pageSize := listOpts.Limit
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		offset := listOpts.Offset
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, pagination, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !pagination.HasMore || len(items) == 0 {
+				return
+			}
+			offset += len(items)
+		}
+	}
+}
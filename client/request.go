@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path"
 
+	"github.com/rubiojr/anytype-go"
 	"github.com/rubiojr/anytype-go/middleware"
 )
 
@@ -40,6 +41,11 @@ func (c *ClientImpl) newRequest(ctx context.Context, method, urlPath string, bod
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
 
+	// Request types implementing Validate are validated by the validation middleware
+	if validator, ok := body.(middleware.Validator); ok {
+		ctx = middleware.WithValidator(ctx, validator)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 	if err != nil {
 		return nil, err
@@ -60,17 +66,8 @@ func (c *ClientImpl) newRequest(ctx context.Context, method, urlPath string, bod
 
 // doRequest executes the HTTP request and unmarshals the response into the result
 func (c *ClientImpl) doRequest(req *http.Request, result interface{}) error {
-	// Create a middleware chain
-	chain := middleware.NewChain(c.httpClient)
-	// Add middleware (e.g., retry, validation)
-	chain.Use(middleware.WithRetry())
-	// chain.Use(middleware.WithValidation())
-
-	// Build the client with middleware chain
-	client := chain.Build()
-
-	// Execute the request
-	resp, err := client.Do(req)
+	// Execute the request through the client's middleware chain
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -79,7 +76,7 @@ func (c *ClientImpl) doRequest(req *http.Request, result interface{}) error {
 	// Handle non-2xx responses
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return anytype.NewAPIError(resp.StatusCode, bodyBytes)
 	}
 
 	// Parse response if a result is expected
@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // SearchClientImpl implements the SearchClient interface
@@ -32,3 +34,22 @@ func (sc *SearchClientImpl) Search(ctx context.Context, request anytype.SearchRe
 
 	return response, nil
 }
+
+// SearchAll returns an iterator over all search results across all spaces, fetching pages lazily
+func (sc *SearchClientImpl) SearchAll(ctx context.Context, request anytype.SearchRequest, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Object, options.PaginationMetadata, error) {
+		endpoint := "/search"
+
+		req, err := sc.client.newRequest(ctx, http.MethodPost, paginatedPath(endpoint, limit, offset), request)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		response := &anytype.SearchResponse{}
+		if err := sc.client.doRequest(req, response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
@@ -3,7 +3,9 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
@@ -51,6 +53,23 @@ func (sc *SpaceClientImpl) List(ctx context.Context) (*anytype.SpaceListResponse
 	return response, nil
 }
 
+// All returns an iterator over all spaces accessible to the user, fetching pages lazily
+func (sc *SpaceClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Space, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Space, options.PaginationMetadata, error) {
+		req, err := sc.client.newRequest(ctx, http.MethodGet, paginatedPath("/spaces", limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		response := &anytype.SpaceListResponse{}
+		if err := sc.client.doRequest(req, response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
 // SpaceContextImpl implements the SpaceContext interface
 type SpaceContextImpl struct {
 	client  *ClientImpl
@@ -84,6 +103,25 @@ func (sc *SpaceContextImpl) Get(ctx context.Context) (*anytype.SpaceResponse, er
 	return response, nil
 }
 
+// Update updates the name, description or icon of this space
+func (sc *SpaceContextImpl) Update(ctx context.Context, request anytype.UpdateSpaceRequest) (*anytype.SpaceResponse, error) {
+	// Create HTTP request
+	endpoint := "/spaces/" + sc.spaceID
+	req, err := sc.client.newRequest(ctx, http.MethodPatch, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request and parse the response
+	response := &anytype.SpaceResponse{}
+	err = sc.client.doRequest(req, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 // Objects returns an ObjectClient for this space
 func (sc *SpaceContextImpl) Objects() anytype.ObjectClient {
 	return &ObjectClientImpl{
@@ -133,22 +171,10 @@ func (sc *SpaceContextImpl) Search(ctx context.Context, request anytype.SearchRe
 
 	// Apply pagination options
 	listOpts := options.ApplyListOptions(opts...)
-	if listOpts.Limit > 0 || listOpts.Offset > 0 {
-		endpoint += "?"
-		if listOpts.Limit > 0 {
-			endpoint += fmt.Sprintf("limit=%d", listOpts.Limit)
-			if listOpts.Offset > 0 {
-				endpoint += "&"
-			}
-		}
-		if listOpts.Offset > 0 {
-			endpoint += fmt.Sprintf("offset=%d", listOpts.Offset)
-		}
-	}
 
 	// Create HTTP request with the request struct directly
 	// The newRequest method will handle JSON marshaling
-	req, err := sc.client.newRequest(ctx, http.MethodPost, endpoint, request)
+	req, err := sc.client.newRequest(ctx, http.MethodPost, paginatedPath(endpoint, listOpts.Limit, listOpts.Offset), request)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +189,18 @@ func (sc *SpaceContextImpl) Search(ctx context.Context, request anytype.SearchRe
 	return response, nil
 }
 
+// SearchAll returns an iterator over all search results within this space, fetching pages lazily
+func (sc *SpaceContextImpl) SearchAll(ctx context.Context, request anytype.SearchRequest, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Object, options.PaginationMetadata, error) {
+		response, err := sc.Search(ctx, request, options.WithLimit(limit), options.WithOffset(offset))
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
 // Members returns a MemberClient for this space
 func (sc *SpaceContextImpl) Members() anytype.MemberClient {
 	return &MemberClientImpl{
@@ -188,6 +226,17 @@ func (sc *SpaceContextImpl) Properties() anytype.SpacePropertyClient {
 	}
 }
 
+// Property returns a PropertyContext for a specific property in this space
+func (sc *SpaceContextImpl) Property(propertyID string) anytype.PropertyContext {
+	return &PropertyContextImpl{
+		client:     sc.client,
+		spaceID:    sc.spaceID,
+		propertyID: propertyID,
+	}
+}
+
+var ErrPropertyNotFound = errors.New("property not found")
+
 // SpacePropertyClientImpl implements the SpacePropertyClient interface
 type SpacePropertyClientImpl struct {
 	client  *ClientImpl
@@ -229,3 +278,116 @@ func (pc *SpacePropertyClientImpl) List(ctx context.Context) ([]anytype.Property
 
 	return response.Data, nil
 }
+
+// All returns an iterator over all properties in the space, fetching pages lazily
+func (pc *SpacePropertyClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Property, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Property, options.PaginationMetadata, error) {
+		endpoint := "/spaces/" + pc.spaceID + "/properties"
+
+		req, err := pc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		var response struct {
+			Data       []anytype.Property         `json:"data"`
+			Pagination options.PaginationMetadata `json:"pagination"`
+		}
+		if err := pc.client.doRequest(req, &response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
+// GetByKey looks up a property by its key
+func (pc *SpacePropertyClientImpl) GetByKey(ctx context.Context, key string) (*anytype.Property, error) {
+	for property, err := range pc.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		if property.Key == key {
+			return &property, nil
+		}
+	}
+
+	return nil, ErrPropertyNotFound
+}
+
+// PropertyContextImpl implements the PropertyContext interface
+type PropertyContextImpl struct {
+	client     *ClientImpl
+	spaceID    string
+	propertyID string
+}
+
+// Get retrieves details of this property
+func (pc *PropertyContextImpl) Get(ctx context.Context) (*anytype.PropertyResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s", pc.spaceID, pc.propertyID)
+
+	req, err := pc.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.PropertyResponse
+	if err := pc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Update updates the name or key of this property
+func (pc *PropertyContextImpl) Update(ctx context.Context, request anytype.UpdatePropertyRequest) (*anytype.PropertyResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s", pc.spaceID, pc.propertyID)
+
+	req, err := pc.client.newRequest(ctx, http.MethodPatch, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.PropertyResponse
+	if err := pc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Delete deletes this property
+func (pc *PropertyContextImpl) Delete(ctx context.Context) (*anytype.PropertyResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s", pc.spaceID, pc.propertyID)
+
+	req, err := pc.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.PropertyResponse
+	if err := pc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Tags returns a TagClient for the tags of this property
+func (pc *PropertyContextImpl) Tags() anytype.TagClient {
+	return &TagClientImpl{
+		client:     pc.client,
+		spaceID:    pc.spaceID,
+		propertyID: pc.propertyID,
+	}
+}
+
+// Tag returns a TagContext for a specific tag of this property
+func (pc *PropertyContextImpl) Tag(tagID string) anytype.TagContext {
+	return &TagContextImpl{
+		client:     pc.client,
+		spaceID:    pc.spaceID,
+		propertyID: pc.propertyID,
+		tagID:      tagID,
+	}
+}
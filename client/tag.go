@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
+)
+
+// TagClientImpl implements the TagClient interface
+type TagClientImpl struct {
+	client     *ClientImpl
+	spaceID    string
+	propertyID string
+}
+
+// List returns all tags of the property
+func (tc *TagClientImpl) List(ctx context.Context) ([]anytype.Tag, error) {
+	tags, _, err := tc.listPage(ctx, 0, 0)
+	return tags, err
+}
+
+// All returns an iterator over all tags of the property, fetching pages lazily
+func (tc *TagClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Tag, error] {
+	return paginate(ctx, tc.listPage, opts...)
+}
+
+// listPage fetches a single page of tags of the property
+func (tc *TagClientImpl) listPage(ctx context.Context, limit, offset int) ([]anytype.Tag, options.PaginationMetadata, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s/tags", tc.spaceID, tc.propertyID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
+	if err != nil {
+		return nil, options.PaginationMetadata{}, err
+	}
+
+	var response struct {
+		Data       []anytype.Tag              `json:"data"`
+		Pagination options.PaginationMetadata `json:"pagination"`
+	}
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, options.PaginationMetadata{}, err
+	}
+
+	return response.Data, response.Pagination, nil
+}
+
+// Create creates a new tag for the property
+func (tc *TagClientImpl) Create(ctx context.Context, request anytype.CreateTagRequest) (*anytype.TagResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s/tags", tc.spaceID, tc.propertyID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodPost, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.TagResponse
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// TagContextImpl implements the TagContext interface
+type TagContextImpl struct {
+	client     *ClientImpl
+	spaceID    string
+	propertyID string
+	tagID      string
+}
+
+// Get retrieves details of this tag
+func (tc *TagContextImpl) Get(ctx context.Context) (*anytype.TagResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s/tags/%s", tc.spaceID, tc.propertyID, tc.tagID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.TagResponse
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Update updates the name or color of this tag
+func (tc *TagContextImpl) Update(ctx context.Context, request anytype.UpdateTagRequest) (*anytype.TagResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s/tags/%s", tc.spaceID, tc.propertyID, tc.tagID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodPatch, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.TagResponse
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Delete deletes this tag
+func (tc *TagContextImpl) Delete(ctx context.Context) (*anytype.TagResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/properties/%s/tags/%s", tc.spaceID, tc.propertyID, tc.tagID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.TagResponse
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
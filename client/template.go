@@ -3,9 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // TemplateClientImpl implements the TemplateClient interface
@@ -41,6 +43,28 @@ func (tc *TemplateClientImpl) List(ctx context.Context) ([]anytype.Template, err
 	return response.Data, nil
 }
 
+// All returns an iterator over all templates for a type, fetching pages lazily
+func (tc *TemplateClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Template, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Template, options.PaginationMetadata, error) {
+		endpoint := fmt.Sprintf("/spaces/%s/types/%s/templates", tc.spaceID, tc.typeID)
+
+		req, err := tc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		var response struct {
+			Data       []anytype.Template         `json:"data"`
+			Pagination options.PaginationMetadata `json:"pagination"`
+		}
+		if err := tc.client.doRequest(req, &response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
 // Get retrieves a specific template by ID
 func (tc *TemplateClientImpl) Get(ctx context.Context, templateID string) (*anytype.Template, error) {
 	endpoint := fmt.Sprintf("/spaces/%s/types/%s/templates/%s", tc.spaceID, tc.typeID, templateID)
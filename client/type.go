@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 var ErrTypeNotFound = errors.New("type not found")
@@ -35,6 +37,28 @@ func (tc *TypeClientImpl) List(ctx context.Context) ([]anytype.Type, error) {
 	return response.Data, nil
 }
 
+// All returns an iterator over all object types in the space, fetching pages lazily
+func (tc *TypeClientImpl) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Type, error] {
+	return paginate(ctx, func(ctx context.Context, limit, offset int) ([]anytype.Type, options.PaginationMetadata, error) {
+		endpoint := fmt.Sprintf("/spaces/%s/types", tc.spaceID)
+
+		req, err := tc.client.newRequest(ctx, http.MethodGet, paginatedPath(endpoint, limit, offset), nil)
+		if err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		var response struct {
+			Data       []anytype.Type             `json:"data"`
+			Pagination options.PaginationMetadata `json:"pagination"`
+		}
+		if err := tc.client.doRequest(req, &response); err != nil {
+			return nil, options.PaginationMetadata{}, err
+		}
+
+		return response.Data, response.Pagination, nil
+	}, opts...)
+}
+
 // Get retrieves details of a specific type by key
 func (tc *TypeClientImpl) Get(ctx context.Context, typeKey string) (*anytype.Type, error) {
 	// Make an HTTP request to GET /spaces/{space_id}/types/{type_key}
@@ -120,6 +144,40 @@ func (tc *TypeContextImpl) Get(ctx context.Context) (*anytype.TypeResponse, erro
 	return &response, nil
 }
 
+// Update updates this type
+func (tc *TypeContextImpl) Update(ctx context.Context, request anytype.UpdateTypeRequest) (*anytype.TypeResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/types/%s", tc.spaceID, tc.typeID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodPatch, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.TypeResponse
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Delete deletes this type
+func (tc *TypeContextImpl) Delete(ctx context.Context) (*anytype.TypeResponse, error) {
+	endpoint := fmt.Sprintf("/spaces/%s/types/%s", tc.spaceID, tc.typeID)
+
+	req, err := tc.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response anytype.TypeResponse
+	if err := tc.client.doRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Templates returns a TemplateClient for this type
 func (tc *TypeContextImpl) Templates() anytype.TemplateClient {
 	return &TemplateClientImpl{
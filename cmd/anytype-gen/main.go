@@ -0,0 +1,142 @@
+// Command anytype-gen generates typed Go models and repositories from the
+// object types of an Anytype space.
+//
+// Types are read either from a running Anytype instance:
+//
+//	ANYTYPE_APP_KEY=... anytype-gen -space <space-id> -out models/models.go
+//
+// or from a schema file previously saved with -dump:
+//
+//	anytype-gen -space <space-id> -dump schema.json
+//	anytype-gen -schema schema.json -package models -out models/models.go
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+	_ "github.com/rubiojr/anytype-go/client" // Register client implementation
+	"github.com/rubiojr/anytype-go/codegen"
+)
+
+func main() {
+	var (
+		baseURL    = flag.String("url", "http://localhost:31009", "Anytype API base URL")
+		appKey     = flag.String("app-key", os.Getenv("ANYTYPE_APP_KEY"), "Anytype app key (defaults to $ANYTYPE_APP_KEY)")
+		spaceID    = flag.String("space", os.Getenv("ANYTYPE_SPACE_ID"), "ID of the space to read types from (defaults to $ANYTYPE_SPACE_ID)")
+		schemaFile = flag.String("schema", "", "read types from a JSON schema file instead of the API")
+		dumpFile   = flag.String("dump", "", "save the types of the space to a JSON schema file and exit")
+		pkg        = flag.String("package", "models", "name of the generated package")
+		out        = flag.String("out", "", "output file (defaults to stdout)")
+		only       = flag.String("types", "", "comma separated list of type keys to generate (defaults to all)")
+	)
+	flag.Parse()
+
+	if err := run(*baseURL, *appKey, *spaceID, *schemaFile, *dumpFile, *pkg, *out, *only); err != nil {
+		fmt.Fprintf(os.Stderr, "anytype-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(baseURL, appKey, spaceID, schemaFile, dumpFile, pkg, out, only string) error {
+	var types []anytype.Type
+	var err error
+	if schemaFile != "" {
+		types, err = readSchema(schemaFile)
+	} else {
+		types, err = fetchTypes(baseURL, appKey, spaceID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dumpFile != "" {
+		data, err := json.MarshalIndent(types, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dumpFile, data, 0o644)
+	}
+
+	types, err = filterTypes(types, only)
+	if err != nil {
+		return err
+	}
+
+	source, err := codegen.Generate(types, codegen.Options{Package: pkg})
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+	return os.WriteFile(out, source, 0o644)
+}
+
+// readSchema reads the types saved with -dump
+func readSchema(path string) ([]anytype.Type, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var types []anytype.Type
+	if err := json.Unmarshal(data, &types); err != nil {
+		return nil, fmt.Errorf("failed to decode schema %s: %w", path, err)
+	}
+	return types, nil
+}
+
+// fetchTypes lists the types of a space through the API
+func fetchTypes(baseURL, appKey, spaceID string) ([]anytype.Type, error) {
+	if appKey == "" || spaceID == "" {
+		return nil, fmt.Errorf("an app key and a space ID are required unless -schema is set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client := anytype.NewClient(
+		anytype.WithBaseURL(baseURL),
+		anytype.WithAppKey(appKey),
+	)
+
+	var types []anytype.Type
+	for typ, err := range client.Space(spaceID).Types().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list types: %w", err)
+		}
+		types = append(types, typ)
+	}
+	return types, nil
+}
+
+// filterTypes keeps the types whose keys are listed in only
+func filterTypes(types []anytype.Type, only string) ([]anytype.Type, error) {
+	if only == "" {
+		return types, nil
+	}
+
+	byKey := make(map[string]anytype.Type, len(types))
+	for _, typ := range types {
+		byKey[typ.Key] = typ
+	}
+
+	var filtered []anytype.Type
+	for _, key := range strings.Split(only, ",") {
+		typ, ok := byKey[strings.TrimSpace(key)]
+		if !ok {
+			return nil, fmt.Errorf("type %q not found", key)
+		}
+		filtered = append(filtered, typ)
+	}
+	return filtered, nil
+}
@@ -0,0 +1,411 @@
+package anytype
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Reserved struct tag keys mapping to object fields rather than properties
+const (
+	codecKeyID      = "id"
+	codecKeyTypeKey = "type_key"
+	codecKeyName    = "name"
+	codecKeyIcon    = "icon"
+	codecKeyBody    = "body"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	tagType  = reflect.TypeOf(Tag{})
+	iconType = reflect.TypeOf(Icon{})
+)
+
+// codecField describes a tagged struct field
+type codecField struct {
+	name      string
+	index     int
+	key       string
+	format    PropertyFormat
+	omitEmpty bool
+}
+
+// Marshal builds a CreateObjectRequest from a struct using its anytype struct
+// tags. Marshal and Unmarshal map Go structs to and from objects using tags
+// of the form:
+//
+//	type Task struct {
+//		Title   string    `anytype:"name"`
+//		Notes   string    `anytype:"body"`
+//		Due     time.Time `anytype:"due_date,date"`
+//		Status  string    `anytype:"status,select"`
+//		Done    bool      `anytype:"done,omitempty"`
+//	}
+//
+// The first tag element is the property key and the second the property
+// format. The format may be omitted for strings (text), numbers (number),
+// bools (checkbox) and time.Time (date). The omitempty option skips zero
+// values when marshalling; nil pointers are always skipped.
+//
+// The reserved keys id, type_key, name, icon and body map to the object ID,
+// type key, name, icon and markdown body instead of properties.
+//
+// Select fields may be a string holding the tag ID or a Tag, and multi-select
+// fields a []string of tag IDs or a []Tag. Files and objects fields are
+// []string of IDs.
+func Marshal(v any) (CreateObjectRequest, error) {
+	var request CreateObjectRequest
+
+	rv, fields, err := codecStruct(v, false)
+	if err != nil {
+		return request, err
+	}
+
+	for _, field := range fields {
+		fv := rv.Field(field.index)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if field.omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		switch field.key {
+		case codecKeyID:
+			// The ID is assigned by the API
+		case codecKeyTypeKey:
+			request.TypeKey = fv.String()
+		case codecKeyName:
+			request.Name = fv.String()
+		case codecKeyBody:
+			request.Body = fv.String()
+		case codecKeyIcon:
+			icon := fv.Interface().(Icon)
+			request.Icon = &icon
+		default:
+			value, err := codecValue(fv, field.format)
+			if err != nil {
+				return request, fmt.Errorf("anytype: field %s: %w", field.name, err)
+			}
+			request.Properties = append(request.Properties, PropertyLinkValue{Key: field.key, Value: value})
+		}
+	}
+
+	return request, nil
+}
+
+// Unmarshal copies the fields and properties of an object into the struct
+// pointed to by v using its anytype struct tags. Properties missing from the
+// object leave their field untouched; properties whose format differs from
+// the field's are reported as errors.
+func Unmarshal(obj *Object, v any) error {
+	if obj == nil {
+		return fmt.Errorf("anytype: cannot unmarshal a nil object")
+	}
+
+	rv, fields, err := codecStruct(v, true)
+	if err != nil {
+		return err
+	}
+
+	properties := make(map[string]PropertyWithValue, len(obj.Properties))
+	for _, property := range obj.Properties {
+		properties[property.Key] = property
+	}
+
+	for _, field := range fields {
+		fv := rv.Field(field.index)
+
+		switch field.key {
+		case codecKeyID:
+			setString(fv, obj.ID)
+		case codecKeyTypeKey:
+			typeKey := obj.TypeKey
+			if typeKey == "" && obj.Type != nil {
+				typeKey = obj.Type.Key
+			}
+			setString(fv, typeKey)
+		case codecKeyName:
+			setString(fv, obj.Name)
+		case codecKeyBody:
+			setString(fv, obj.Markdown)
+		case codecKeyIcon:
+			if obj.Icon != nil {
+				settable(fv).Set(reflect.ValueOf(*obj.Icon))
+			}
+		default:
+			property, ok := properties[field.key]
+			if !ok {
+				continue
+			}
+			if property.Value == nil {
+				return fmt.Errorf("anytype: field %s: property %q has unknown format %q", field.name, field.key, property.Format)
+			}
+			if property.Value.Format() != field.format {
+				return fmt.Errorf("anytype: field %s: property %q has format %q, field expects %q", field.name, field.key, property.Value.Format(), field.format)
+			}
+			if err := setValue(fv, property.Value); err != nil {
+				return fmt.Errorf("anytype: field %s: %w", field.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// codecStruct validates v and returns its struct value and tagged fields.
+// When pointer is true v must be a non-nil pointer to a struct.
+func codecStruct(v any, pointer bool) (reflect.Value, []codecField, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	} else if pointer {
+		return rv, nil, fmt.Errorf("anytype: expected a non-nil pointer to a struct, got %T", v)
+	}
+	if rv.Kind() != reflect.Struct {
+		return rv, nil, fmt.Errorf("anytype: expected a struct, got %T", v)
+	}
+
+	fields, err := codecFields(rv.Type())
+	if err != nil {
+		return rv, nil, err
+	}
+
+	return rv, fields, nil
+}
+
+// codecFields parses the anytype struct tags of t
+func codecFields(t reflect.Type) ([]codecField, error) {
+	var fields []codecField
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("anytype")
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		field := codecField{name: sf.Name, index: i, key: parts[0]}
+		if field.key == "" {
+			return nil, fmt.Errorf("anytype: field %s: missing property key", sf.Name)
+		}
+
+		for _, option := range parts[1:] {
+			if option == "omitempty" {
+				field.omitEmpty = true
+			} else if option != "" {
+				field.format = PropertyFormat(option)
+			}
+		}
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		switch field.key {
+		case codecKeyID, codecKeyTypeKey, codecKeyName, codecKeyBody:
+			if ft.Kind() != reflect.String {
+				return nil, fmt.Errorf("anytype: field %s: %q requires a string, got %s", sf.Name, field.key, sf.Type)
+			}
+		case codecKeyIcon:
+			if ft != iconType {
+				return nil, fmt.Errorf("anytype: field %s: %q requires an Icon, got %s", sf.Name, field.key, sf.Type)
+			}
+		default:
+			if field.format == "" {
+				field.format = inferFormat(ft)
+				if field.format == "" {
+					return nil, fmt.Errorf("anytype: field %s: cannot infer property format for %s", sf.Name, sf.Type)
+				}
+			}
+			if !field.format.IsValid() {
+				return nil, fmt.Errorf("anytype: field %s: unknown property format %q", sf.Name, field.format)
+			}
+			if !formatAccepts(field.format, ft) {
+				return nil, fmt.Errorf("anytype: field %s: %s cannot hold a %q property", sf.Name, sf.Type, field.format)
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// inferFormat returns the default property format for a Go type
+func inferFormat(t reflect.Type) PropertyFormat {
+	switch {
+	case t == timeType:
+		return PropertyFormatDate
+	case t.Kind() == reflect.String:
+		return PropertyFormatText
+	case t.Kind() == reflect.Bool:
+		return PropertyFormatCheckbox
+	case isNumber(t):
+		return PropertyFormatNumber
+	}
+	return ""
+}
+
+// formatAccepts reports whether a field of type t can hold a property of the given format
+func formatAccepts(format PropertyFormat, t reflect.Type) bool {
+	switch format {
+	case PropertyFormatText, PropertyFormatURL, PropertyFormatEmail, PropertyFormatPhone:
+		return t.Kind() == reflect.String
+	case PropertyFormatNumber:
+		return isNumber(t)
+	case PropertyFormatCheckbox:
+		return t.Kind() == reflect.Bool
+	case PropertyFormatDate:
+		return t == timeType
+	case PropertyFormatSelect:
+		return t.Kind() == reflect.String || t == tagType
+	case PropertyFormatMultiSelect:
+		return t.Kind() == reflect.Slice && (t.Elem().Kind() == reflect.String || t.Elem() == tagType)
+	case PropertyFormatFiles, PropertyFormatObjects:
+		return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String
+	}
+	return false
+}
+
+// isNumber reports whether t is an integer or floating point type
+func isNumber(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// codecValue converts a field value into a PropertyValue of the given format
+func codecValue(fv reflect.Value, format PropertyFormat) (PropertyValue, error) {
+	switch format {
+	case PropertyFormatText:
+		return TextValue{Text: fv.String()}, nil
+	case PropertyFormatURL:
+		return URLValue{URL: fv.String()}, nil
+	case PropertyFormatEmail:
+		return EmailValue{Email: fv.String()}, nil
+	case PropertyFormatPhone:
+		return PhoneValue{Phone: fv.String()}, nil
+	case PropertyFormatNumber:
+		return NumberValue{Number: fv.Convert(reflect.TypeOf(float64(0))).Float()}, nil
+	case PropertyFormatCheckbox:
+		return CheckboxValue{Checkbox: fv.Bool()}, nil
+	case PropertyFormatDate:
+		return DateValue{Date: fv.Interface().(time.Time)}, nil
+	case PropertyFormatSelect:
+		if fv.Type() == tagType {
+			tag := fv.Interface().(Tag)
+			return SelectValue{Tag: &tag}, nil
+		}
+		return SelectValue{Tag: &Tag{ID: fv.String()}}, nil
+	case PropertyFormatMultiSelect:
+		tags := make([]Tag, 0, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			if item := fv.Index(i); item.Type() == tagType {
+				tags = append(tags, item.Interface().(Tag))
+			} else {
+				tags = append(tags, Tag{ID: item.String()})
+			}
+		}
+		return MultiSelectValue{Tags: tags}, nil
+	case PropertyFormatFiles:
+		return FilesValue{Files: stringSlice(fv)}, nil
+	case PropertyFormatObjects:
+		return ObjectsValue{Objects: stringSlice(fv)}, nil
+	}
+	return nil, fmt.Errorf("unsupported property format %q", format)
+}
+
+// setValue stores a PropertyValue into a field, allocating pointers as needed
+func setValue(fv reflect.Value, value PropertyValue) error {
+	fv = settable(fv)
+
+	switch v := value.(type) {
+	case TextValue:
+		fv.SetString(v.Text)
+	case URLValue:
+		fv.SetString(v.URL)
+	case EmailValue:
+		fv.SetString(v.Email)
+	case PhoneValue:
+		fv.SetString(v.Phone)
+	case NumberValue:
+		fv.Set(reflect.ValueOf(v.Number).Convert(fv.Type()))
+	case CheckboxValue:
+		fv.SetBool(v.Checkbox)
+	case DateValue:
+		fv.Set(reflect.ValueOf(v.Date))
+	case SelectValue:
+		var tag Tag
+		if v.Tag != nil {
+			tag = *v.Tag
+		}
+		if fv.Type() == tagType {
+			fv.Set(reflect.ValueOf(tag))
+		} else {
+			fv.SetString(tag.ID)
+		}
+	case MultiSelectValue:
+		items := reflect.MakeSlice(fv.Type(), 0, len(v.Tags))
+		for _, tag := range v.Tags {
+			if fv.Type().Elem() == tagType {
+				items = reflect.Append(items, reflect.ValueOf(tag))
+			} else {
+				items = reflect.Append(items, reflect.ValueOf(tag.ID).Convert(fv.Type().Elem()))
+			}
+		}
+		fv.Set(items)
+	case FilesValue:
+		setStrings(fv, v.Files)
+	case ObjectsValue:
+		setStrings(fv, v.Objects)
+	default:
+		return fmt.Errorf("unsupported property value %T", value)
+	}
+
+	return nil
+}
+
+// settable dereferences pointer fields, allocating them when nil
+func settable(fv reflect.Value) reflect.Value {
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return fv.Elem()
+	}
+	return fv
+}
+
+// setString stores s into a string or *string field
+func setString(fv reflect.Value, s string) {
+	settable(fv).SetString(s)
+}
+
+// setStrings stores values into a slice of strings field
+func setStrings(fv reflect.Value, values []string) {
+	items := reflect.MakeSlice(fv.Type(), 0, len(values))
+	for _, value := range values {
+		items = reflect.Append(items, reflect.ValueOf(value).Convert(fv.Type().Elem()))
+	}
+	fv.Set(items)
+}
+
+// stringSlice returns the elements of a slice of strings field
+func stringSlice(fv reflect.Value) []string {
+	values := make([]string, 0, fv.Len())
+	for i := 0; i < fv.Len(); i++ {
+		values = append(values, fv.Index(i).String())
+	}
+	return values
+}
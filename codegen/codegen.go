@@ -0,0 +1,311 @@
+// Package codegen generates typed Go models and repositories from the object
+// types of a space.
+//
+// For every type it emits a struct mapped with anytype struct tags (see
+// anytype.Marshal) and a repository offering Create, Get, List, Update and
+// Delete on top of a SpaceContext, so services no longer refer to property
+// keys as strings and schema drift shows up as compile errors after
+// regenerating.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// Options configures the generated code
+type Options struct {
+	// Package is the name of the generated package, "models" by default
+	Package string
+}
+
+// reservedKeys lists the keys mapped to object fields by the codec
+var reservedKeys = map[string]bool{
+	"id":       true,
+	"type_key": true,
+	"name":     true,
+	"icon":     true,
+	"body":     true,
+}
+
+// goTypes maps property formats to the Go types of the generated fields
+var goTypes = map[anytype.PropertyFormat]string{
+	anytype.PropertyFormatText:        "string",
+	anytype.PropertyFormatNumber:      "float64",
+	anytype.PropertyFormatSelect:      "anytype.Tag",
+	anytype.PropertyFormatMultiSelect: "[]anytype.Tag",
+	anytype.PropertyFormatDate:        "time.Time",
+	anytype.PropertyFormatFiles:       "[]string",
+	anytype.PropertyFormatCheckbox:    "bool",
+	anytype.PropertyFormatURL:         "string",
+	anytype.PropertyFormatEmail:       "string",
+	anytype.PropertyFormatPhone:       "string",
+	anytype.PropertyFormatObjects:     "[]string",
+}
+
+// model is the template data for a single type
+type model struct {
+	Name   string
+	Key    string
+	Title  string
+	Fields []field
+}
+
+// field is the template data for a single property
+type field struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+// Generate returns the gofmt'ed Go source of the models and repositories for
+// the given types. Types are emitted in key order so the output is stable.
+func Generate(types []anytype.Type, opts Options) ([]byte, error) {
+	if opts.Package == "" {
+		opts.Package = "models"
+	}
+
+	sorted := append([]anytype.Type(nil), types...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+
+	var models []model
+	names := make(map[string]string)
+	hasTime := false
+	for _, typ := range sorted {
+		if typ.Key == "" {
+			return nil, fmt.Errorf("codegen: type %q has no key", typ.Name)
+		}
+
+		m := model{
+			Name:  GoName(typ.Name),
+			Key:   typ.Key,
+			Title: typ.Name,
+		}
+		if m.Name == "" {
+			m.Name = GoName(typ.Key)
+		}
+		if other, ok := names[m.Name]; ok {
+			return nil, fmt.Errorf("codegen: types %q and %q both map to %s", other, typ.Key, m.Name)
+		}
+		names[m.Name] = typ.Key
+
+		fields, err := modelFields(typ)
+		if err != nil {
+			return nil, err
+		}
+		m.Fields = fields
+		for _, f := range fields {
+			if f.Type == "time.Time" {
+				hasTime = true
+			}
+		}
+
+		models = append(models, m)
+	}
+
+	var buf bytes.Buffer
+	err := fileTemplate.Execute(&buf, struct {
+		Package string
+		Models  []model
+		HasTime bool
+	}{opts.Package, models, hasTime})
+	if err != nil {
+		return nil, fmt.Errorf("codegen: %w", err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("codegen: generated invalid code: %w", err)
+	}
+
+	return source, nil
+}
+
+// modelFields returns the struct fields for the properties of a type
+func modelFields(typ anytype.Type) ([]field, error) {
+	fields := []field{
+		{Name: "ID", Type: "string", Tag: `anytype:"id"`},
+		{Name: "Name", Type: "string", Tag: `anytype:"name"`},
+		{Name: "Icon", Type: "*anytype.Icon", Tag: `anytype:"icon"`},
+	}
+	used := map[string]bool{"ID": true, "Name": true, "Icon": true}
+
+	for _, definition := range typ.PropertyDefinitions {
+		if reservedKeys[definition.Key] || anytype.IsReadOnlyProperty(definition.Key) {
+			continue
+		}
+
+		format := anytype.PropertyFormat(definition.Format)
+		goType, ok := goTypes[format]
+		if !ok {
+			return nil, fmt.Errorf("codegen: property %q of type %q has unknown format %q", definition.Key, typ.Key, definition.Format)
+		}
+
+		name := GoName(definition.Name)
+		if name == "" || used[name] {
+			name = GoName(definition.Key)
+		}
+		if used[name] {
+			return nil, fmt.Errorf("codegen: property %q of type %q collides with field %s", definition.Key, typ.Key, name)
+		}
+		used[name] = true
+
+		// Zero values are skipped so that updates leave unset properties
+		// alone; numbers and checkboxes are always sent so 0 and false stick
+		options := ",omitempty"
+		if format == anytype.PropertyFormatNumber || format == anytype.PropertyFormatCheckbox {
+			options = ""
+		}
+
+		fields = append(fields, field{
+			Name: name,
+			Type: goType,
+			Tag:  fmt.Sprintf(`anytype:"%s,%s%s"`, definition.Key, format, options),
+		})
+	}
+
+	return fields, nil
+}
+
+// commonInitialisms lists words rendered in upper case in Go names
+var commonInitialisms = map[string]bool{
+	"ID": true, "URL": true, "API": true, "HTTP": true, "JSON": true, "HTML": true, "UUID": true,
+}
+
+// GoName converts a type or property name such as "Due date" or "due_date"
+// into an exported Go identifier such as DueDate
+func GoName(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); commonInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	name := b.String()
+	if name != "" && !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by anytype-gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	{{- if .HasTime}}
+	"time"
+	{{- end}}
+
+	"github.com/rubiojr/anytype-go"
+)
+{{range .Models}}
+// {{.Name}}TypeKey is the key of the {{printf "%q" .Title}} type
+const {{.Name}}TypeKey = {{printf "%q" .Key}}
+
+// {{.Name}} is an object of the {{printf "%q" .Title}} type
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+}
+
+// {{.Name}}Repo provides typed access to {{.Name}} objects
+type {{.Name}}Repo struct {
+	space anytype.SpaceContext
+}
+
+// New{{.Name}}Repo returns a {{.Name}}Repo for the given space
+func New{{.Name}}Repo(space anytype.SpaceContext) *{{.Name}}Repo {
+	return &{{.Name}}Repo{space: space}
+}
+
+// Create creates a new {{.Name}} and returns it as stored by the API
+func (r *{{.Name}}Repo) Create(ctx context.Context, v *{{.Name}}) (*{{.Name}}, error) {
+	request, err := anytype.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	request.TypeKey = {{.Name}}TypeKey
+
+	response, err := r.space.Objects().Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var created {{.Name}}
+	if err := anytype.Unmarshal(response.Object, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// Get retrieves the {{.Name}} with the given ID
+func (r *{{.Name}}Repo) Get(ctx context.Context, id string) (*{{.Name}}, error) {
+	response, err := r.space.Object(id).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var v {{.Name}}
+	if err := anytype.Unmarshal(response.Object, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// List returns all {{.Name}} objects in the space
+func (r *{{.Name}}Repo) List(ctx context.Context) ([]{{.Name}}, error) {
+	var list []{{.Name}}
+	request := anytype.SearchRequest{Types: []string{ {{- .Name}}TypeKey}}
+	for object, err := range r.space.SearchAll(ctx, request) {
+		if err != nil {
+			return nil, err
+		}
+
+		var v {{.Name}}
+		if err := anytype.Unmarshal(&object, &v); err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+// Update saves the name, icon and properties of v
+func (r *{{.Name}}Repo) Update(ctx context.Context, v *{{.Name}}) error {
+	request, err := anytype.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return r.space.Object(v.ID).Update(ctx, anytype.UpdateObjectRequest{
+		Name:       request.Name,
+		Icon:       request.Icon,
+		Properties: request.Properties,
+	})
+}
+
+// Delete deletes the {{.Name}} with the given ID
+func (r *{{.Name}}Repo) Delete(ctx context.Context, id string) error {
+	_, err := r.space.Object(id).Delete(ctx)
+	return err
+}
+{{end}}`))
@@ -0,0 +1,153 @@
+// Package csvio exports objects to CSV and imports CSV rows as objects, so
+// spaces can be edited in a spreadsheet.
+//
+// The exported CSV starts with an "id" and a "name" column, followed by one
+// column per property found on the exported objects, titled with the property
+// name. Such a file can be imported back into the space, updating the objects
+// by ID:
+//
+//	err := csvio.ExportType(ctx, client.Space(spaceID), "task", file)
+//	...
+//	result, err := csvio.Import(ctx, client.Space(spaceID), "task", file, csvio.Mapping{Upsert: true})
+package csvio
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+const (
+	// IDColumn is the default column holding object IDs
+	IDColumn = "id"
+	// NameColumn is the default column holding object names
+	NameColumn = "name"
+)
+
+// listSeparator separates the items of multi-select, objects and files cells
+const listSeparator = ", "
+
+// ExportView writes the objects of a list view as CSV, one row per object
+func ExportView(ctx context.Context, list anytype.ListContext, viewID string, w io.Writer) error {
+	return export(list.View(viewID).Objects().All(ctx), w)
+}
+
+// ExportType writes the objects of a type as CSV, one row per object
+func ExportType(ctx context.Context, space anytype.SpaceContext, typeKey string, w io.Writer) error {
+	return export(space.SearchAll(ctx, anytype.SearchRequest{Types: []string{typeKey}}), w)
+}
+
+// export writes the objects as CSV. Columns are the properties found on the
+// objects, in order of first appearance.
+func export(objects iter.Seq2[anytype.Object, error], w io.Writer) error {
+	type column struct {
+		key  string
+		name string
+	}
+
+	var (
+		rows    []anytype.Object
+		columns []column
+		seen    = make(map[string]bool)
+	)
+	for obj, err := range objects {
+		if err != nil {
+			return fmt.Errorf("csvio: failed to list objects: %w", err)
+		}
+		rows = append(rows, obj)
+
+		for _, property := range obj.Properties {
+			if seen[property.Key] || property.Value == nil {
+				continue
+			}
+			seen[property.Key] = true
+
+			name := property.Name
+			if name == "" {
+				name = property.Key
+			}
+			columns = append(columns, column{key: property.Key, name: name})
+		}
+	}
+
+	cw := csv.NewWriter(w)
+
+	header := []string{IDColumn, NameColumn}
+	for _, c := range columns {
+		header = append(header, c.name)
+	}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("csvio: %w", err)
+	}
+
+	for _, obj := range rows {
+		values := make(map[string]anytype.PropertyValue, len(obj.Properties))
+		for _, property := range obj.Properties {
+			values[property.Key] = property.Value
+		}
+
+		record := []string{obj.ID, obj.Name}
+		for _, c := range columns {
+			record = append(record, formatCell(values[c.key]))
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("csvio: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("csvio: %w", err)
+	}
+	return nil
+}
+
+// formatCell returns the text of a property value. Tags are written by name,
+// and dates without a time of day as YYYY-MM-DD.
+func formatCell(value anytype.PropertyValue) string {
+	switch v := value.(type) {
+	case anytype.TextValue:
+		return v.Text
+	case anytype.URLValue:
+		return v.URL
+	case anytype.EmailValue:
+		return v.Email
+	case anytype.PhoneValue:
+		return v.Phone
+	case anytype.NumberValue:
+		return strconv.FormatFloat(v.Number, 'f', -1, 64)
+	case anytype.CheckboxValue:
+		return strconv.FormatBool(v.Checkbox)
+	case anytype.DateValue:
+		switch {
+		case v.Date.IsZero():
+			return ""
+		case v.Date.Equal(v.Date.Truncate(24 * time.Hour)):
+			return v.Date.Format(time.DateOnly)
+		}
+		return v.Date.Format(time.RFC3339)
+	case anytype.SelectValue:
+		if v.Tag == nil {
+			return ""
+		}
+		return v.Tag.Name
+	case anytype.MultiSelectValue:
+		names := make([]string, len(v.Tags))
+		for i, tag := range v.Tags {
+			names[i] = tag.Name
+		}
+		return strings.Join(names, listSeparator)
+	case anytype.FilesValue:
+		return strings.Join(v.Files, listSeparator)
+	case anytype.ObjectsValue:
+		return strings.Join(v.Objects, listSeparator)
+	}
+	return ""
+}
@@ -0,0 +1,369 @@
+package csvio
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// dateLayouts are the date formats accepted in date cells
+var dateLayouts = []string{time.RFC3339, time.DateTime, "2006-01-02 15:04", time.DateOnly, "2006/01/02"}
+
+// Mapping describes how the columns of a CSV file map to objects
+type Mapping struct {
+	// Name is the column holding the object names, NameColumn by default
+	Name string
+
+	// ID is the column holding the IDs of the objects to update, IDColumn by
+	// default. It is only used with Upsert.
+	ID string
+
+	// Properties maps columns to property keys. When nil, columns are mapped
+	// to the properties whose key or name matches the column title, ignoring
+	// case; other columns and read-only properties are ignored.
+	Properties map[string]string
+
+	// Upsert updates the object whose ID is in the ID column, or whose name
+	// matches the row among the objects of the type, instead of creating a
+	// new object. Rows whose name matches several objects fail with an
+	// *anytype.UpsertConflictError.
+	Upsert bool
+}
+
+// RowError is the error of an imported row
+type RowError struct {
+	// Row is the line number of the row in the file, the header being line 1
+	Row int
+	Err error
+}
+
+// Error implements the error interface
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e RowError) Unwrap() error {
+	return e.Err
+}
+
+// ImportResult summarizes an import
+type ImportResult struct {
+	// Created is the number of created objects
+	Created int
+	// Updated is the number of updated objects
+	Updated int
+	// Failed lists the rows that could not be imported
+	Failed []RowError
+}
+
+// Import creates an object of the given type for every row of the CSV,
+// converting cells to the format of their property. Select and multi-select
+// cells hold tag names, and missing tags are created; multi-select, objects
+// and files cells are comma separated lists. Empty cells are left unset.
+//
+// Rows that fail are recorded in the result and do not stop the import; an
+// error is returned only when the CSV or the space cannot be read.
+func Import(ctx context.Context, space anytype.SpaceContext, typeKey string, r io.Reader, mapping Mapping) (*ImportResult, error) {
+	if mapping.Name == "" {
+		mapping.Name = NameColumn
+	}
+	if mapping.ID == "" {
+		mapping.ID = IDColumn
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("csvio: failed to read header: %w", err)
+	}
+
+	properties := make(map[string]anytype.Property)
+	for property, err := range space.Properties().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("csvio: failed to list properties: %w", err)
+		}
+		properties[property.Key] = property
+	}
+
+	columns, err := mapColumns(header, mapping, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string][]string)
+	if mapping.Upsert {
+		for obj, err := range space.SearchAll(ctx, anytype.SearchRequest{Types: []string{typeKey}}) {
+			if err != nil {
+				return nil, fmt.Errorf("csvio: failed to list objects: %w", err)
+			}
+			name := strings.ToLower(obj.Name)
+			existing[name] = append(existing[name], obj.ID)
+		}
+	}
+
+	imp := &importer{
+		space:    space,
+		typeKey:  typeKey,
+		upsert:   mapping.Upsert,
+		header:   header,
+		columns:  columns,
+		existing: existing,
+		tags:     make(map[string]map[string]string),
+	}
+	result := &ImportResult{}
+
+	for row := 2; ; row++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csvio: %w", err)
+		}
+
+		created, err := imp.row(ctx, record)
+		switch {
+		case err != nil:
+			result.Failed = append(result.Failed, RowError{Row: row, Err: err})
+		case created:
+			result.Created++
+		default:
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
+
+// column is the role of a CSV column
+type column struct {
+	name     bool
+	id       bool
+	property *anytype.Property
+}
+
+// mapColumns resolves the role of each column of the header
+func mapColumns(header []string, mapping Mapping, properties map[string]anytype.Property) ([]column, error) {
+	columns := make([]column, len(header))
+
+	for i, title := range header {
+		switch {
+		case strings.EqualFold(title, mapping.Name):
+			columns[i].name = true
+		case strings.EqualFold(title, mapping.ID):
+			columns[i].id = true
+		case mapping.Properties != nil:
+			key, ok := mapping.Properties[title]
+			if !ok {
+				continue
+			}
+			property, ok := properties[key]
+			if !ok {
+				return nil, fmt.Errorf("csvio: column %q: property %q: %w", title, key, anytype.ErrNotFound)
+			}
+			columns[i].property = &property
+		default:
+			columns[i].property = findProperty(title, properties)
+		}
+	}
+
+	return columns, nil
+}
+
+// findProperty returns the writable property whose key, or else name,
+// matches a column title
+func findProperty(title string, properties map[string]anytype.Property) *anytype.Property {
+	var byName *anytype.Property
+	for _, property := range properties {
+		if anytype.IsReadOnlyProperty(property.Key) {
+			continue
+		}
+		if strings.EqualFold(title, property.Key) {
+			return &property
+		}
+		if byName == nil && strings.EqualFold(title, property.Name) {
+			byName = &property
+		}
+	}
+	return byName
+}
+
+// importer holds the state of an import
+type importer struct {
+	space   anytype.SpaceContext
+	typeKey string
+	upsert  bool
+	header  []string
+	columns []column
+
+	// existing are the IDs of the objects of the type, by lowercase name
+	existing map[string][]string
+	// tags are the tag IDs of select properties, by property key and lowercase tag name
+	tags map[string]map[string]string
+}
+
+// row creates or updates the object of a row, and reports whether it was created
+func (imp *importer) row(ctx context.Context, record []string) (bool, error) {
+	var (
+		name, id string
+		values   []anytype.PropertyLinkValue
+	)
+	for i, cell := range record {
+		if i >= len(imp.columns) || cell == "" {
+			continue
+		}
+
+		switch c := imp.columns[i]; {
+		case c.name:
+			name = cell
+		case c.id:
+			id = cell
+		case c.property != nil:
+			value, err := imp.value(ctx, *c.property, cell)
+			if err != nil {
+				return false, fmt.Errorf("column %q: %w", imp.header[i], err)
+			}
+			values = append(values, value)
+		}
+	}
+
+	if !imp.upsert {
+		id = ""
+	} else if id == "" {
+		switch ids := imp.existing[strings.ToLower(name)]; len(ids) {
+		case 0:
+		case 1:
+			id = ids[0]
+		default:
+			return false, &anytype.UpsertConflictError{Key: anytype.UpsertByName(), Value: name, ObjectIDs: ids}
+		}
+	}
+
+	if id != "" {
+		err := imp.space.Object(id).Update(ctx, anytype.UpdateObjectRequest{Name: name, Properties: values})
+		return false, err
+	}
+
+	resp, err := imp.space.Objects().Create(ctx, anytype.CreateObjectRequest{
+		TypeKey:    imp.typeKey,
+		Name:       name,
+		Properties: values,
+	})
+	if err != nil {
+		return false, err
+	}
+	if imp.upsert {
+		imp.existing[strings.ToLower(name)] = []string{resp.Object.ID}
+	}
+	return true, nil
+}
+
+// value converts a cell to a value of the property
+func (imp *importer) value(ctx context.Context, property anytype.Property, cell string) (anytype.PropertyLinkValue, error) {
+	key := property.Key
+
+	switch anytype.PropertyFormat(property.Format) {
+	case anytype.PropertyFormatText:
+		return anytype.TextProperty(key, cell), nil
+	case anytype.PropertyFormatURL:
+		return anytype.URLProperty(key, cell), nil
+	case anytype.PropertyFormatEmail:
+		return anytype.EmailProperty(key, cell), nil
+	case anytype.PropertyFormatPhone:
+		return anytype.PhoneProperty(key, cell), nil
+	case anytype.PropertyFormatNumber:
+		n, err := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+		if err != nil {
+			return anytype.PropertyLinkValue{}, fmt.Errorf("invalid number %q", cell)
+		}
+		return anytype.NumberProperty(key, n), nil
+	case anytype.PropertyFormatCheckbox:
+		switch strings.ToLower(strings.TrimSpace(cell)) {
+		case "true", "yes", "y", "x", "1":
+			return anytype.CheckboxProperty(key, true), nil
+		case "false", "no", "n", "0":
+			return anytype.CheckboxProperty(key, false), nil
+		}
+		return anytype.PropertyLinkValue{}, fmt.Errorf("invalid checkbox %q", cell)
+	case anytype.PropertyFormatDate:
+		for _, layout := range dateLayouts {
+			if date, err := time.Parse(layout, strings.TrimSpace(cell)); err == nil {
+				return anytype.DateProperty(key, date), nil
+			}
+		}
+		return anytype.PropertyLinkValue{}, fmt.Errorf("invalid date %q", cell)
+	case anytype.PropertyFormatSelect:
+		id, err := imp.tag(ctx, property, strings.TrimSpace(cell))
+		if err != nil {
+			return anytype.PropertyLinkValue{}, err
+		}
+		return anytype.SelectProperty(key, id), nil
+	case anytype.PropertyFormatMultiSelect:
+		var ids []string
+		for _, name := range splitList(cell) {
+			id, err := imp.tag(ctx, property, name)
+			if err != nil {
+				return anytype.PropertyLinkValue{}, err
+			}
+			ids = append(ids, id)
+		}
+		return anytype.MultiSelectProperty(key, ids...), nil
+	case anytype.PropertyFormatObjects:
+		return anytype.ObjectsProperty(key, splitList(cell)...), nil
+	case anytype.PropertyFormatFiles:
+		return anytype.FilesProperty(key, splitList(cell)...), nil
+	}
+
+	return anytype.PropertyLinkValue{}, fmt.Errorf("unsupported property format %q", property.Format)
+}
+
+// tag returns the ID of a tag of a select property by name, creating it when missing
+func (imp *importer) tag(ctx context.Context, property anytype.Property, name string) (string, error) {
+	tags, ok := imp.tags[property.Key]
+	if !ok {
+		tags = make(map[string]string)
+		for tag, err := range imp.space.Property(property.ID).Tags().All(ctx) {
+			if err != nil {
+				return "", fmt.Errorf("failed to list tags of property %q: %w", property.Key, err)
+			}
+			tags[strings.ToLower(tag.Name)] = tag.ID
+		}
+		imp.tags[property.Key] = tags
+	}
+
+	if id, ok := tags[strings.ToLower(name)]; ok {
+		return id, nil
+	}
+
+	resp, err := imp.space.Property(property.ID).Tags().Create(ctx, anytype.CreateTagRequest{
+		Name:  name,
+		Color: anytype.ColorGrey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create tag %q of property %q: %w", name, property.Key, err)
+	}
+
+	tags[strings.ToLower(name)] = resp.Tag.ID
+	return resp.Tag.ID, nil
+}
+
+// splitList splits a comma separated cell
+func splitList(cell string) []string {
+	var items []string
+	for _, item := range strings.Split(cell, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
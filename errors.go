@@ -0,0 +1,109 @@
+package anytype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matching the error responses described by the API.
+// Use errors.Is to test an error returned by the client against them.
+var (
+	// ErrValidation is returned when the API rejects a request as invalid (400)
+	ErrValidation = errors.New("validation error")
+	// ErrUnauthorized is returned when the app key is missing or invalid (401)
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is returned when the app key lacks access to a resource (403)
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound is returned when the requested resource does not exist (404)
+	ErrNotFound = errors.New("not found")
+	// ErrGone is returned when the requested resource has been deleted (410)
+	ErrGone = errors.New("resource gone")
+	// ErrRateLimited is returned when too many requests have been sent (429)
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrServer is returned when the API fails with an internal error (5xx)
+	ErrServer = errors.New("server error")
+)
+
+// ErrUnsupported is returned for operations the API does not provide
+var ErrUnsupported = errors.New("operation not supported by the API")
+
+// ErrConflict is returned when an operation expects a single object but
+// several match, see UpsertConflictError
+var ErrConflict = errors.New("conflict")
+
+// APIError represents an error response returned by the Anytype API
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int `json:"-"`
+	// Status is the status reported in the error body
+	Status int `json:"status,omitempty"`
+	// Code is the machine readable error code, e.g. "object_not_found"
+	Code string `json:"code,omitempty"`
+	// Message is the human readable error message
+	Message string `json:"message,omitempty"`
+	// Object is the data model of the error, usually "error"
+	Object string `json:"object,omitempty"`
+	// Body is the raw response body
+	Body []byte `json:"-"`
+}
+
+// NewAPIError builds an APIError from a non-2xx response status code and body.
+// Bodies that are not valid util.*Error JSON are kept as the error message.
+func NewAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{}
+	if err := json.Unmarshal(body, apiErr); err != nil || (apiErr.Code == "" && apiErr.Message == "") {
+		apiErr = &APIError{Message: string(body)}
+	}
+	apiErr.StatusCode = statusCode
+	apiErr.Body = body
+	return apiErr
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	switch {
+	case e.Code != "" && e.Message != "":
+		return fmt.Sprintf("request failed with status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+	case e.Message != "":
+		return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Message)
+	case e.Code != "":
+		return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Code)
+	default:
+		return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+}
+
+// Is reports whether the error matches one of the sentinel errors,
+// based on the HTTP status code of the response
+func (e *APIError) Is(target error) bool {
+	return target != nil && target == e.sentinel()
+}
+
+// sentinel returns the sentinel error corresponding to the status code
+func (e *APIError) sentinel() error {
+	switch {
+	case e.StatusCode == http.StatusBadRequest:
+		return ErrValidation
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusGone:
+		return ErrGone
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrServer
+	default:
+		return nil
+	}
+}
+
+// IsNotFound reports whether err is an API error for a missing or deleted resource
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || errors.Is(err, ErrGone)
+}
@@ -286,19 +286,10 @@ func workWithTypes(ctx context.Context, client anytype.Client, spaceID string) e
 			Format: anytype.IconFormatEmoji,
 			Emoji:  "💻",
 		},
-		Properties: []map[string]any{
-			{
-				"key":    "price",
-				"number": 1299.99,
-			},
-			{
-				"key":  "category",
-				"text": "Electronics",
-			},
-			{
-				"key":  "description",
-				"text": "High-performance gaming laptop with advanced graphics card.",
-			},
+		Properties: []anytype.PropertyLinkValue{
+			anytype.NumberProperty("price", 1299.99),
+			anytype.TextProperty("category", "Electronics"),
+			anytype.TextProperty("description", "High-performance gaming laptop with advanced graphics card."),
 		},
 	}
 
@@ -322,7 +313,7 @@ func workWithTypes(ctx context.Context, client anytype.Client, spaceID string) e
 		if objectDetails.Object.Properties != nil {
 			fmt.Printf("  Properties:\n")
 			for _, value := range objectDetails.Object.Properties {
-				fmt.Printf("    %s: %+v\n", value.Key, value)
+				fmt.Printf("    %s: %+v\n", value.Key, value.Value)
 			}
 		}
 	}
@@ -385,7 +376,7 @@ func workWithObjects(ctx context.Context, client anytype.Client, spaceID, templa
 
 	// Export the object to markdown
 	fmt.Println("Exporting object to markdown...")
-	exportResp, err := client.Space(spaceID).Object(objectID).Export(ctx, "markdown")
+	exportResp, err := client.Space(spaceID).Object(objectID).Export(ctx, anytype.ExportFormatMarkdown)
 	if err != nil {
 		log.Printf("Failed to export object: %v", err)
 	} else {
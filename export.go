@@ -1,7 +1,278 @@
 package anytype
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExportFormat represents a format objects can be exported to
+type ExportFormat string
+
+const (
+	// ExportFormatMarkdown exports the markdown body with a YAML front matter built from the properties
+	ExportFormatMarkdown ExportFormat = "markdown"
+	// ExportFormatJSON exports the object as indented JSON
+	ExportFormatJSON ExportFormat = "json"
+	// ExportFormatHTML exports an HTML document rendered from the markdown body
+	ExportFormatHTML ExportFormat = "html"
+	// ExportFormatText exports the body as plain text without markdown syntax
+	ExportFormatText ExportFormat = "text"
+)
+
 // ExportResult represents the result of an object export operation
 type ExportResult struct {
+	// Format is the format the object was exported to
+	Format ExportFormat `json:"format"`
+	// Content is the exported document
+	Content string `json:"content"`
+	// Markdown is the markdown body of the object
 	Markdown string `json:"markdown,omitempty"`
-	// Add other export format fields as they become available
+}
+
+// Exporter converts an object into a document of a given format
+type Exporter interface {
+	Export(obj *Object) (string, error)
+}
+
+// ExporterFunc adapts a function to the Exporter interface
+type ExporterFunc func(obj *Object) (string, error)
+
+// Export calls f(obj)
+func (f ExporterFunc) Export(obj *Object) (string, error) {
+	return f(obj)
+}
+
+var (
+	exportersMu sync.RWMutex
+	exporters   = map[ExportFormat]Exporter{
+		ExportFormatMarkdown: ExporterFunc(exportMarkdown),
+		ExportFormatJSON:     ExporterFunc(exportJSON),
+		ExportFormatHTML:     ExporterFunc(exportHTML),
+		ExportFormatText:     ExporterFunc(exportText),
+	}
+)
+
+// RegisterExporter registers an exporter for a format, replacing any
+// exporter previously registered for it
+func RegisterExporter(format ExportFormat, exporter Exporter) {
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	exporters[format] = exporter
+}
+
+// ExportFormats returns the registered export formats in alphabetical order
+func ExportFormats() []ExportFormat {
+	exportersMu.RLock()
+	defer exportersMu.RUnlock()
+
+	formats := make([]ExportFormat, 0, len(exporters))
+	for format := range exporters {
+		formats = append(formats, format)
+	}
+	sort.Slice(formats, func(i, j int) bool { return formats[i] < formats[j] })
+	return formats
+}
+
+// ExportObject exports an object with the exporter registered for the format.
+// It returns an error wrapping ErrUnsupported for unknown formats.
+func ExportObject(obj *Object, format ExportFormat) (*ExportResult, error) {
+	exportersMu.RLock()
+	exporter, ok := exporters[format]
+	exportersMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("export format %q: %w", format, ErrUnsupported)
+	}
+
+	if obj == nil {
+		return nil, fmt.Errorf("cannot export a nil object")
+	}
+
+	content, err := exporter.Export(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export object %s as %s: %w", obj.ID, format, err)
+	}
+
+	return &ExportResult{
+		Format:   format,
+		Content:  content,
+		Markdown: obj.Markdown,
+	}, nil
+}
+
+// exportMarkdown returns the markdown body preceded by a YAML front matter
+func exportMarkdown(obj *Object) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	writeYAMLField(&b, "id", strconv.Quote(obj.ID))
+	writeYAMLField(&b, "name", strconv.Quote(obj.Name))
+	if typeKey := objectTypeKey(obj); typeKey != "" {
+		writeYAMLField(&b, "type", strconv.Quote(typeKey))
+	}
+	for _, property := range obj.Properties {
+		if property.Value == nil || property.Key == "" {
+			continue
+		}
+		writeYAMLField(&b, property.Key, yamlValue(property.Value))
+	}
+	b.WriteString("---\n")
+
+	if obj.Markdown != "" {
+		b.WriteString("\n")
+		b.WriteString(obj.Markdown)
+		if !strings.HasSuffix(obj.Markdown, "\n") {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String(), nil
+}
+
+// writeYAMLField writes a key: value line, quoting keys that are not plain
+func writeYAMLField(b *strings.Builder, key, value string) {
+	if strings.ContainsAny(key, ": #\"'") {
+		key = strconv.Quote(key)
+	}
+	fmt.Fprintf(b, "%s: %s\n", key, value)
+}
+
+// yamlValue renders a property value as a YAML flow value. Strings are
+// double-quoted, which YAML accepts with Go escaping rules.
+func yamlValue(value PropertyValue) string {
+	quoteAll := func(values []string) string {
+		quoted := make([]string, len(values))
+		for i, v := range values {
+			quoted[i] = strconv.Quote(v)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	}
+
+	switch v := value.(type) {
+	case TextValue:
+		return strconv.Quote(v.Text)
+	case URLValue:
+		return strconv.Quote(v.URL)
+	case EmailValue:
+		return strconv.Quote(v.Email)
+	case PhoneValue:
+		return strconv.Quote(v.Phone)
+	case NumberValue:
+		return strconv.FormatFloat(v.Number, 'f', -1, 64)
+	case CheckboxValue:
+		return strconv.FormatBool(v.Checkbox)
+	case DateValue:
+		if v.Date.IsZero() {
+			return "null"
+		}
+		return strconv.Quote(v.Date.Format(time.RFC3339))
+	case SelectValue:
+		if v.Tag == nil {
+			return "null"
+		}
+		return strconv.Quote(tagLabel(*v.Tag))
+	case MultiSelectValue:
+		names := make([]string, len(v.Tags))
+		for i, tag := range v.Tags {
+			names[i] = tagLabel(tag)
+		}
+		return quoteAll(names)
+	case FilesValue:
+		return quoteAll(v.Files)
+	case ObjectsValue:
+		return quoteAll(v.Objects)
+	}
+	return "null"
+}
+
+// tagLabel returns the name of a tag, or its ID when the name is unknown
+func tagLabel(tag Tag) string {
+	if tag.Name != "" {
+		return tag.Name
+	}
+	return tag.ID
+}
+
+// objectTypeKey returns the type key of an object
+func objectTypeKey(obj *Object) string {
+	if obj.TypeKey != "" {
+		return obj.TypeKey
+	}
+	if obj.Type != nil {
+		return obj.Type.Key
+	}
+	return ""
+}
+
+// exportJSON returns the object as indented JSON
+func exportJSON(obj *Object) (string, error) {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+// exportText returns the body as plain text, one paragraph per block and
+// one line per list item
+func exportText(obj *Object) (string, error) {
+	var paragraphs []string
+	if obj.Name != "" {
+		paragraphs = append(paragraphs, obj.Name)
+	}
+	inList := false
+
+	blocks := BlocksFromMarkdown(obj.Markdown)
+	depths := blockDepths(blocks)
+	for _, block := range blocks {
+		if block.Text == nil {
+			continue
+		}
+
+		text := block.Text.Content
+		if block.Text.Style != TextStyleCode {
+			text = stripInlineMarkdown(text)
+		}
+
+		indent := strings.Repeat("  ", depths[block.ID])
+		switch block.Text.Style {
+		case TextStyleMarked, TextStyleNumbered:
+			text = indent + "- " + text
+		case TextStyleCheckbox:
+			mark := "[ ] "
+			if block.Text.Checked {
+				mark = "[x] "
+			}
+			text = indent + mark + text
+		default:
+			paragraphs = append(paragraphs, text)
+			inList = false
+			continue
+		}
+
+		if inList {
+			paragraphs[len(paragraphs)-1] += "\n" + text
+		} else {
+			paragraphs = append(paragraphs, text)
+			inList = true
+		}
+	}
+
+	return strings.Join(paragraphs, "\n\n") + "\n", nil
+}
+
+// blockDepths returns the nesting depth of each block, by ID
+func blockDepths(blocks []Block) map[string]int {
+	depths := make(map[string]int, len(blocks))
+	for _, block := range blocks {
+		for _, child := range block.ChildrenIDs {
+			depths[child] = depths[block.ID] + 1
+		}
+	}
+	return depths
 }
@@ -0,0 +1,263 @@
+// Package export backs up a whole space to a directory tree.
+//
+// Every object is written as a markdown file with a YAML front matter holding
+// its properties, under a directory named after its type. Links between
+// objects are rewritten to relative paths so the tree can be browsed offline,
+// and the types, properties and tags of the space are saved alongside as
+// JSON:
+//
+//	result, err := export.Space(ctx, client.Space(spaceID), "backup", export.Options{
+//		Incremental: true,
+//	})
+//
+// A manifest recording the path and last modified date of every object is
+// kept in the directory. In incremental mode objects that have not changed
+// since the previous run are skipped, and files of deleted objects are
+// removed.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// DefaultConcurrency is the number of objects fetched in parallel when
+// Options.Concurrency is not set
+const DefaultConcurrency = 4
+
+// LastModifiedKey is the key of the property holding the last modified date
+// of an object
+const LastModifiedKey = "last_modified_date"
+
+// Options configures an export
+type Options struct {
+	// Concurrency limits the number of objects fetched and written in
+	// parallel, DefaultConcurrency by default
+	Concurrency int
+
+	// Incremental skips objects whose last modified date has not changed
+	// since the previous export to the same directory
+	Incremental bool
+}
+
+// Result summarizes an export
+type Result struct {
+	// Exported is the number of object files written
+	Exported int
+	// Skipped is the number of unchanged objects skipped in incremental mode
+	Skipped int
+	// Removed is the number of files removed because their object no longer exists
+	Removed int
+}
+
+// Space exports all objects of the space, and its schema, to dir
+func Space(ctx context.Context, space anytype.SpaceContext, dir string, opts Options) (*Result, error) {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+
+	previous, err := loadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeSchema(ctx, space, dir); err != nil {
+		return nil, err
+	}
+
+	var objects []anytype.Object
+	for obj, err := range space.Objects().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("export: failed to list objects: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+
+	current := &manifest{Objects: make(map[string]manifestEntry, len(objects))}
+	paths := assignPaths(objects, previous)
+
+	result := &Result{}
+	var pending []anytype.Object
+	for _, obj := range objects {
+		entry := manifestEntry{Path: paths[obj.ID], LastModified: lastModified(obj)}
+		current.Objects[obj.ID] = entry
+
+		if opts.Incremental && previous.unchanged(obj.ID, entry, dir) {
+			result.Skipped++
+			continue
+		}
+		pending = append(pending, obj)
+	}
+
+	if err := exportObjects(ctx, space, dir, pending, paths, opts.Concurrency); err != nil {
+		return nil, err
+	}
+	result.Exported = len(pending)
+
+	for id, entry := range previous.Objects {
+		if _, ok := current.Objects[id]; ok {
+			continue
+		}
+		err := os.Remove(filepath.Join(dir, filepath.FromSlash(entry.Path)))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("export: %w", err)
+		}
+		result.Removed++
+	}
+
+	if err := current.save(dir); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// exportObjects fetches and writes objects, at most concurrency at a time.
+// It stops at the first error.
+func exportObjects(ctx context.Context, space anytype.SpaceContext, dir string, objects []anytype.Object, paths map[string]string, concurrency int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	jobs := make(chan anytype.Object)
+
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for obj := range jobs {
+				if err := exportObject(ctx, space, dir, obj.ID, paths); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	for _, obj := range objects {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- obj
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// exportObject fetches an object with its markdown body and writes it to its file
+func exportObject(ctx context.Context, space anytype.SpaceContext, dir, objectID string, paths map[string]string) error {
+	resp, err := space.Object(objectID).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("export: failed to get object %s: %w", objectID, err)
+	}
+
+	result, err := anytype.ExportObject(resp.Object, anytype.ExportFormatMarkdown)
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+
+	path := paths[objectID]
+	content := rewriteLinks(result.Content, path, paths)
+
+	file := filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	return nil
+}
+
+// writeSchema saves the types, properties and tags of the space as JSON files
+func writeSchema(ctx context.Context, space anytype.SpaceContext, dir string) error {
+	var types []anytype.Type
+	for typ, err := range space.Types().All(ctx) {
+		if err != nil {
+			return fmt.Errorf("export: failed to list types: %w", err)
+		}
+		types = append(types, typ)
+	}
+
+	var properties []anytype.Property
+	tags := make(map[string][]anytype.Tag)
+	for property, err := range space.Properties().All(ctx) {
+		if err != nil {
+			return fmt.Errorf("export: failed to list properties: %w", err)
+		}
+		properties = append(properties, property)
+
+		if property.Format != string(anytype.PropertyFormatSelect) && property.Format != string(anytype.PropertyFormatMultiSelect) {
+			continue
+		}
+		for tag, err := range space.Property(property.ID).Tags().All(ctx) {
+			if err != nil {
+				return fmt.Errorf("export: failed to list tags of property %q: %w", property.Key, err)
+			}
+			tags[property.Key] = append(tags[property.Key], tag)
+		}
+	}
+
+	files := map[string]any{
+		"types.json":      types,
+		"properties.json": properties,
+		"tags.json":       tags,
+	}
+	for name, v := range files {
+		if err := writeJSON(filepath.Join(dir, name), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJSON writes v as indented JSON
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	return nil
+}
+
+// lastModified returns the last modified date of an object, or the zero
+// time when the object does not expose it
+func lastModified(obj anytype.Object) time.Time {
+	for _, property := range obj.Properties {
+		if property.Key != LastModifiedKey {
+			continue
+		}
+		if date, ok := property.Value.(anytype.DateValue); ok {
+			return date.Date
+		}
+	}
+	return time.Time{}
+}
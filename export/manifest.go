@@ -0,0 +1,65 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ManifestFile is the name of the file recording the exported objects
+const ManifestFile = "manifest.json"
+
+// manifest records the exported objects, by ID
+type manifest struct {
+	Objects map[string]manifestEntry `json:"objects"`
+}
+
+// manifestEntry records where an object was written and its last modified date
+type manifestEntry struct {
+	Path         string    `json:"path"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// loadManifest reads the manifest of a previous export, returning an empty
+// manifest when there is none
+func loadManifest(dir string) (*manifest, error) {
+	m := &manifest{Objects: make(map[string]manifestEntry)}
+
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("export: failed to decode %s: %w", ManifestFile, err)
+	}
+	if m.Objects == nil {
+		m.Objects = make(map[string]manifestEntry)
+	}
+	return m, nil
+}
+
+// save writes the manifest to dir
+func (m *manifest) save(dir string) error {
+	return writeJSON(filepath.Join(dir, ManifestFile), m)
+}
+
+// unchanged reports whether the object was exported to the same path with
+// the same last modified date, and its file still exists
+func (m *manifest) unchanged(id string, entry manifestEntry, dir string) bool {
+	previous, ok := m.Objects[id]
+	if !ok || entry.LastModified.IsZero() {
+		return false
+	}
+	if previous.Path != entry.Path || !previous.LastModified.Equal(entry.LastModified) {
+		return false
+	}
+
+	_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(entry.Path)))
+	return err == nil
+}
@@ -0,0 +1,115 @@
+package export
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+var (
+	linkRe     = regexp.MustCompile(`\]\(([^)\s]+)\)`)
+	objectIDRe = regexp.MustCompile(`objectId=([^&#)]+)`)
+)
+
+// assignPaths returns the slash separated path of every object file,
+// relative to the export directory. Objects keep the path they were exported
+// to previously, so renaming an object does not break links from files
+// skipped in incremental mode. Other objects are written to
+// <type>/<name>.md, with the object ID appended to the name on collisions.
+// Paths of deleted objects stay reserved, as their files are removed after
+// the new ones are written.
+func assignPaths(objects []anytype.Object, previous *manifest) map[string]string {
+	paths := make(map[string]string, len(objects))
+	taken := make(map[string]bool, len(objects)+len(previous.Objects))
+
+	for _, entry := range previous.Objects {
+		taken[entry.Path] = true
+	}
+	for _, obj := range objects {
+		if entry, ok := previous.Objects[obj.ID]; ok {
+			paths[obj.ID] = entry.Path
+		}
+	}
+
+	for _, obj := range objects {
+		if _, ok := paths[obj.ID]; ok {
+			continue
+		}
+
+		typeDir := "objects"
+		if obj.Type != nil && obj.Type.Key != "" {
+			typeDir = slug(obj.Type.Key)
+		} else if obj.TypeKey != "" {
+			typeDir = slug(obj.TypeKey)
+		}
+
+		name := slug(obj.Name)
+		if name == "" {
+			name = slug(obj.ID)
+		}
+
+		p := path.Join(typeDir, name+".md")
+		if taken[p] {
+			p = path.Join(typeDir, name+"-"+slug(obj.ID)+".md")
+		}
+		paths[obj.ID] = p
+		taken[p] = true
+	}
+
+	return paths
+}
+
+// slug turns a name into a lowercase file name made of letters, digits and dashes
+func slug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// rewriteLinks replaces markdown links to exported objects with links
+// relative to the file at from
+func rewriteLinks(content, from string, paths map[string]string) string {
+	return linkRe.ReplaceAllStringFunc(content, func(link string) string {
+		target := linkRe.FindStringSubmatch(link)[1]
+
+		to, ok := paths[linkedObjectID(target)]
+		if !ok {
+			return link
+		}
+		return "](" + relativePath(from, to) + ")"
+	})
+}
+
+// linkedObjectID returns the ID of the object a link target points to: the
+// objectId parameter of anytype:// links, or the last path segment otherwise
+func linkedObjectID(target string) string {
+	if m := objectIDRe.FindStringSubmatch(target); m != nil {
+		return m[1]
+	}
+	return target[strings.LastIndex(target, "/")+1:]
+}
+
+// relativePath returns the slash separated path of to, relative to the
+// directory of from
+func relativePath(from, to string) string {
+	rel, err := filepath.Rel(filepath.Dir(filepath.FromSlash(from)), filepath.FromSlash(to))
+	if err != nil {
+		return to
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,171 @@
+package anytype
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	inlineCodeRe   = regexp.MustCompile("`([^`]+)`")
+	inlineLinkRe   = regexp.MustCompile(`\[([^\]]+)\]\(((?:[^()\s]|\([^()\s]*\))+)\)`)
+	inlineBoldRe   = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	inlineItalicRe = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+	inlineStrikeRe = regexp.MustCompile(`~~([^~]+)~~`)
+)
+
+// exportHTML returns an HTML document rendered from the markdown body
+func exportHTML(obj *Object) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>" + html.EscapeString(obj.Name) + "</title>\n")
+	b.WriteString("</head>\n<body>\n")
+
+	blocks := BlocksFromMarkdown(obj.Markdown)
+	byID := make(map[string]Block, len(blocks))
+	children := make(map[string]bool)
+	for _, block := range blocks {
+		byID[block.ID] = block
+		for _, child := range block.ChildrenIDs {
+			children[child] = true
+		}
+	}
+
+	var topLevel []Block
+	for _, block := range blocks {
+		if !children[block.ID] {
+			topLevel = append(topLevel, block)
+		}
+	}
+	renderHTMLBlocks(&b, topLevel, byID)
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String(), nil
+}
+
+// renderHTMLBlocks renders sibling blocks, grouping consecutive list items
+// into a single list
+func renderHTMLBlocks(b *strings.Builder, blocks []Block, byID map[string]Block) {
+	for i := 0; i < len(blocks); i++ {
+		block := blocks[i]
+		if block.Text == nil {
+			continue
+		}
+
+		tag := listTag(block.Text.Style)
+		if tag == "" {
+			renderHTMLBlock(b, block)
+			continue
+		}
+
+		b.WriteString("<" + tag + ">\n")
+		for ; i < len(blocks) && blocks[i].Text != nil && listTag(blocks[i].Text.Style) == tag; i++ {
+			item := blocks[i]
+			b.WriteString("<li>")
+			if item.Text.Style == TextStyleCheckbox {
+				if item.Text.Checked {
+					b.WriteString(`<input type="checkbox" checked disabled> `)
+				} else {
+					b.WriteString(`<input type="checkbox" disabled> `)
+				}
+			}
+			b.WriteString(renderInlineHTML(item.Text.Content))
+
+			if len(item.ChildrenIDs) > 0 {
+				b.WriteString("\n")
+				nested := make([]Block, 0, len(item.ChildrenIDs))
+				for _, id := range item.ChildrenIDs {
+					nested = append(nested, byID[id])
+				}
+				renderHTMLBlocks(b, nested, byID)
+			}
+			b.WriteString("</li>\n")
+		}
+		b.WriteString("</" + tag + ">\n")
+		i--
+	}
+}
+
+// renderHTMLBlock renders a block that is not a list item
+func renderHTMLBlock(b *strings.Builder, block Block) {
+	content := block.Text.Content
+
+	switch block.Text.Style {
+	case TextStyleHeader1, TextStyleHeader2, TextStyleHeader3, TextStyleHeader4:
+		level := strings.TrimPrefix(block.Text.Style, "Header")
+		b.WriteString("<h" + level + ">" + renderInlineHTML(content) + "</h" + level + ">\n")
+	case TextStyleQuote:
+		b.WriteString("<blockquote>" + strings.ReplaceAll(renderInlineHTML(content), "\n", "<br>\n") + "</blockquote>\n")
+	case TextStyleCode:
+		b.WriteString("<pre><code>" + html.EscapeString(content) + "</code></pre>\n")
+	default:
+		b.WriteString("<p>" + strings.ReplaceAll(renderInlineHTML(content), "\n", "<br>\n") + "</p>\n")
+	}
+}
+
+// listTag returns the HTML list element for a list item style, or "" for other styles
+func listTag(style string) string {
+	switch style {
+	case TextStyleMarked, TextStyleCheckbox:
+		return "ul"
+	case TextStyleNumbered:
+		return "ol"
+	}
+	return ""
+}
+
+// renderInlineHTML escapes text and renders inline code, links, bold,
+// italic and strikethrough markdown
+func renderInlineHTML(text string) string {
+	text = html.EscapeString(text)
+
+	// Code spans are rendered first and protected from further formatting
+	var spans []string
+	text = inlineCodeRe.ReplaceAllStringFunc(text, func(s string) string {
+		spans = append(spans, "<code>"+inlineCodeRe.FindStringSubmatch(s)[1]+"</code>")
+		return "\x00" + strconv.Itoa(len(spans)-1) + "\x00"
+	})
+
+	text = inlineLinkRe.ReplaceAllStringFunc(text, func(s string) string {
+		m := inlineLinkRe.FindStringSubmatch(s)
+		if !safeLinkTarget(html.UnescapeString(m[2])) {
+			return s
+		}
+		return `<a href="` + m[2] + `">` + m[1] + `</a>`
+	})
+	text = inlineBoldRe.ReplaceAllString(text, "<strong>$1$2</strong>")
+	text = inlineItalicRe.ReplaceAllString(text, "<em>$1$2</em>")
+	text = inlineStrikeRe.ReplaceAllString(text, "<del>$1</del>")
+
+	for i, span := range spans {
+		text = strings.Replace(text, "\x00"+strconv.Itoa(i)+"\x00", span, 1)
+	}
+	return text
+}
+
+// safeLinkTarget reports whether a link target can be exported as a link:
+// http, https and mailto URLs, and relative references. Other schemes, such as
+// javascript:, would run or open content when the document is viewed.
+func safeLinkTarget(target string) bool {
+	scheme, _, found := strings.Cut(target, ":")
+	if !found || strings.ContainsAny(scheme, "/?#") {
+		return true
+	}
+	switch strings.ToLower(scheme) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// stripInlineMarkdown removes inline markdown syntax, keeping the text
+func stripInlineMarkdown(text string) string {
+	text = inlineCodeRe.ReplaceAllString(text, "$1")
+	text = inlineLinkRe.ReplaceAllString(text, "$1 ($2)")
+	text = inlineBoldRe.ReplaceAllString(text, "$1$2")
+	text = inlineItalicRe.ReplaceAllString(text, "$1$2")
+	text = inlineStrikeRe.ReplaceAllString(text, "$1")
+	return text
+}
@@ -0,0 +1,351 @@
+package anytype
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilterCondition is the condition of a list view filter
+type FilterCondition string
+
+const (
+	FilterConditionEqual          FilterCondition = "equal"
+	FilterConditionNotEqual       FilterCondition = "not_equal"
+	FilterConditionGreater        FilterCondition = "greater"
+	FilterConditionLess           FilterCondition = "less"
+	FilterConditionGreaterOrEqual FilterCondition = "greater_or_equal"
+	FilterConditionLessOrEqual    FilterCondition = "less_or_equal"
+	FilterConditionLike           FilterCondition = "like"
+	FilterConditionNotLike        FilterCondition = "not_like"
+	FilterConditionIn             FilterCondition = "in"
+	FilterConditionNotIn          FilterCondition = "not_in"
+	FilterConditionEmpty          FilterCondition = "empty"
+	FilterConditionNotEmpty       FilterCondition = "not_empty"
+	FilterConditionAllIn          FilterCondition = "all_in"
+	FilterConditionNotAllIn       FilterCondition = "not_all_in"
+	FilterConditionExactIn        FilterCondition = "exact_in"
+	FilterConditionNotExactIn     FilterCondition = "not_exact_in"
+	FilterConditionExists         FilterCondition = "exists"
+)
+
+// negatedConditions maps the negative conditions to the condition they negate
+var negatedConditions = map[FilterCondition]FilterCondition{
+	FilterConditionNotEqual:   FilterConditionEqual,
+	FilterConditionNotLike:    FilterConditionLike,
+	FilterConditionNotIn:      FilterConditionIn,
+	FilterConditionNotAllIn:   FilterConditionAllIn,
+	FilterConditionNotExactIn: FilterConditionExactIn,
+}
+
+// filterDateLayouts are the formats accepted for dates in filter values
+var filterDateLayouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+
+// MatchFilter reports whether the object matches a list view filter.
+//
+// Values are converted to the type of the property: numbers, checkboxes
+// (true/false) and dates (RFC 3339, date only or unix seconds, date only
+// values comparing with the calendar day of the property). Select and
+// multi-select items compare by tag ID, key or name ignoring case, objects and
+// files items by ID. The in conditions take comma separated values, and
+// unchecked checkboxes are empty. Unknown conditions match no object.
+//
+// Besides property keys, filters on "name", "id" and "type" apply to the name,
+// ID and type key of the object.
+func MatchFilter(filter ListFilter, obj Object) bool {
+	op := lookupOperand(obj, filter.PropertyKey)
+
+	switch condition := FilterCondition(filter.Condition); condition {
+	case FilterConditionExists:
+		return op.exists
+	case FilterConditionEmpty:
+		return op.empty()
+	case FilterConditionNotEmpty:
+		return !op.empty()
+	case FilterConditionEqual:
+		return op.has(filter.Value)
+	case FilterConditionGreater, FilterConditionLess, FilterConditionGreaterOrEqual, FilterConditionLessOrEqual:
+		cmp, ok := compareScalar(op.scalar, filter.Value)
+		if !ok {
+			return false
+		}
+		switch condition {
+		case FilterConditionGreater:
+			return cmp > 0
+		case FilterConditionLess:
+			return cmp < 0
+		case FilterConditionGreaterOrEqual:
+			return cmp >= 0
+		default:
+			return cmp <= 0
+		}
+	case FilterConditionLike:
+		return op.like(filter.Value)
+	case FilterConditionIn:
+		for _, value := range SplitFilterValues(filter.Value) {
+			if op.has(value) {
+				return true
+			}
+		}
+		return false
+	case FilterConditionAllIn, FilterConditionExactIn:
+		values := SplitFilterValues(filter.Value)
+		for _, value := range values {
+			if !op.has(value) {
+				return false
+			}
+		}
+		if condition == FilterConditionExactIn && len(op.items()) != len(values) {
+			return false
+		}
+		return len(values) > 0
+	case FilterConditionNotEqual, FilterConditionNotLike, FilterConditionNotIn, FilterConditionNotAllIn, FilterConditionNotExactIn:
+		filter.Condition = string(negatedConditions[condition])
+		return !MatchFilter(filter, obj)
+	}
+	return false
+}
+
+// operand is the value of a property of an object, as compared by filters
+type operand struct {
+	// exists reports whether the object carries the property
+	exists bool
+
+	// scalar is the string, float64, bool or time.Time value of scalar
+	// formats, nil when unset
+	scalar any
+
+	// list reports whether the property has a list format, whose items are
+	// the aliases each item can be referred to by: tags by ID, key and name,
+	// objects and files by ID. The last alias is the displayed one.
+	list      bool
+	listItems [][]string
+}
+
+// lookupOperand returns the operand of a property of the object
+func lookupOperand(obj Object, key string) operand {
+	switch key {
+	case "id":
+		return operand{exists: true, scalar: obj.ID}
+	case "name":
+		return operand{exists: true, scalar: obj.Name}
+	case "type":
+		typeKey := obj.TypeKey
+		if obj.Type != nil && obj.Type.Key != "" {
+			typeKey = obj.Type.Key
+		}
+		return operand{exists: true, scalar: typeKey}
+	}
+
+	for _, property := range obj.Properties {
+		if property.Key != key {
+			continue
+		}
+
+		op := operand{exists: true}
+		switch v := property.Value.(type) {
+		case TextValue:
+			op.scalar = v.Text
+		case URLValue:
+			op.scalar = v.URL
+		case EmailValue:
+			op.scalar = v.Email
+		case PhoneValue:
+			op.scalar = v.Phone
+		case NumberValue:
+			op.scalar = v.Number
+		case CheckboxValue:
+			op.scalar = v.Checkbox
+		case DateValue:
+			if !v.Date.IsZero() {
+				op.scalar = v.Date
+			}
+		case SelectValue:
+			op.list = true
+			if v.Tag != nil {
+				op.listItems = append(op.listItems, tagAliases(*v.Tag))
+			}
+		case MultiSelectValue:
+			op.list = true
+			for _, tag := range v.Tags {
+				op.listItems = append(op.listItems, tagAliases(tag))
+			}
+		case ObjectsValue:
+			op.list = true
+			for _, id := range v.Objects {
+				op.listItems = append(op.listItems, []string{id})
+			}
+		case FilesValue:
+			op.list = true
+			for _, id := range v.Files {
+				op.listItems = append(op.listItems, []string{id})
+			}
+		}
+		return op
+	}
+
+	return operand{}
+}
+
+// tagAliases returns the strings a tag can be referred to by
+func tagAliases(tag Tag) []string {
+	return []string{tag.ID, tag.Key, tag.Name}
+}
+
+// empty reports whether the operand has no value. Unchecked checkboxes are empty.
+func (op operand) empty() bool {
+	if op.list {
+		return len(op.listItems) == 0
+	}
+	switch v := op.scalar.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	}
+	return false
+}
+
+// items returns the items of a list operand, or the scalar as a single item
+func (op operand) items() [][]string {
+	if op.list {
+		return op.listItems
+	}
+	if op.empty() {
+		return nil
+	}
+	return [][]string{{formatScalar(op.scalar)}}
+}
+
+// like reports whether the operand, or one of its items, contains text, ignoring case
+func (op operand) like(text string) bool {
+	text = strings.ToLower(text)
+	for _, item := range op.items() {
+		for _, alias := range item {
+			if strings.Contains(strings.ToLower(alias), text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// has reports whether the scalar equals value, or an item of a list has an
+// alias equal to value, ignoring case
+func (op operand) has(value string) bool {
+	if !op.list {
+		cmp, ok := compareScalar(op.scalar, value)
+		return ok && cmp == 0
+	}
+	for _, item := range op.listItems {
+		for _, alias := range item {
+			if alias != "" && strings.EqualFold(alias, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compareScalar compares a scalar property value with a value of the same
+// type or a string, which is converted to the type of the property value
+func compareScalar(scalar, value any) (int, bool) {
+	switch a := scalar.(type) {
+	case string:
+		b, ok := value.(string)
+		if !ok {
+			b = formatScalar(value)
+		}
+		return strings.Compare(a, b), true
+	case float64:
+		b, ok := value.(float64)
+		if s, isString := value.(string); isString {
+			n, err := strconv.ParseFloat(s, 64)
+			b, ok = n, err == nil
+		}
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		}
+		return 0, true
+	case bool:
+		b, ok := value.(bool)
+		if s, isString := value.(string); isString {
+			parsed, err := strconv.ParseBool(s)
+			b, ok = parsed, err == nil
+		}
+		switch {
+		case !ok:
+			return 0, false
+		case a == b:
+			return 0, true
+		case b:
+			return -1, true
+		}
+		return 1, true
+	case time.Time:
+		b, ok := value.(time.Time)
+		if s, isString := value.(string); isString {
+			b, ok = ParseFilterDate(s)
+
+			// Date only values compare with the calendar day of the date
+			if _, err := time.Parse(time.DateOnly, s); err == nil {
+				year, month, day := a.Date()
+				a = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+			}
+		}
+		if !ok {
+			return 0, false
+		}
+		return a.Compare(b), true
+	}
+	return 0, false
+}
+
+// ParseFilterDate parses a date given in a filter value: RFC 3339, date
+// only or unix seconds
+func ParseFilterDate(s string) (time.Time, bool) {
+	for _, layout := range filterDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	if unix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(unix, 0), true
+	}
+	return time.Time{}, false
+}
+
+// formatScalar returns a scalar value as a string
+func formatScalar(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	}
+	return ""
+}
+
+// SplitFilterValues splits the comma separated values of the in conditions,
+// dropping empty values
+func SplitFilterValues(s string) []string {
+	var values []string
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,201 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field is a front matter entry. Value is a string, float64, bool,
+// time.Time or []string.
+type field struct {
+	Key   string
+	Value any
+}
+
+// dateLayouts are the date formats recognized in front matter values
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// parseNote splits a note into its front matter fields and markdown body.
+//
+// Only the subset of YAML found in note front matter is supported: scalars,
+// quoted strings, flow lists ([a, b]) and block lists ("- a" lines). Nested
+// mappings are ignored.
+func parseNote(content string) ([]field, string, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return nil, content, nil
+	}
+
+	// The front matter is closed by the first line that is exactly ---
+	rest := content[len("---\n"):]
+	for start := 0; start < len(rest); {
+		line, next := rest[start:], len(rest)
+		if i := strings.IndexByte(line, '\n'); i >= 0 {
+			line, next = line[:i], start+i+1
+		}
+
+		if line == "---" {
+			fields, err := parseFrontMatter(strings.TrimSuffix(rest[:start], "\n"))
+			if err != nil {
+				return nil, "", err
+			}
+			return fields, strings.TrimLeft(rest[next:], "\n"), nil
+		}
+		start = next
+	}
+
+	return nil, "", fmt.Errorf("front matter is not closed")
+}
+
+// parseFrontMatter parses the YAML between the front matter delimiters
+func parseFrontMatter(yaml string) ([]field, error) {
+	var fields []field
+	lines := strings.Split(yaml, "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("front matter line %d: expected key: value", i+1)
+		}
+		key = unquote(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if value != "" {
+			v, err := parseValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("front matter key %q: %w", key, err)
+			}
+			fields = append(fields, field{Key: key, Value: v})
+			continue
+		}
+
+		// A key without value is either a block list or empty
+		var items []string
+		for i+1 < len(lines) {
+			next := strings.TrimSpace(lines[i+1])
+			if next != "-" && !strings.HasPrefix(next, "- ") {
+				break
+			}
+			i++
+			if item := unquote(stripComment(strings.TrimSpace(strings.TrimPrefix(next, "-")))); item != "" {
+				items = append(items, item)
+			}
+		}
+		if items != nil {
+			fields = append(fields, field{Key: key, Value: items})
+		}
+	}
+
+	return fields, nil
+}
+
+// parseValue parses a scalar or flow list
+func parseValue(value string) (any, error) {
+	// An unquoted wikilink is kept as a string rather than a nested list
+	if strings.HasPrefix(value, "[") && !strings.HasPrefix(value, "[[") {
+		end := strings.LastIndex(value, "]")
+		if end < 0 {
+			return nil, fmt.Errorf("list is not closed")
+		}
+
+		items := []string{}
+		for _, item := range splitList(value[1:end]) {
+			if item = unquote(strings.TrimSpace(item)); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+
+	if value[0] == '"' || value[0] == '\'' {
+		return unquote(value), nil
+	}
+
+	value = stripComment(value)
+	switch value {
+	case "true", "True", "yes":
+		return true, nil
+	case "false", "False", "no":
+		return false, nil
+	case "null", "~":
+		return nil, nil
+	}
+
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return n, nil
+	}
+	if date, ok := parseDate(value); ok {
+		return date, nil
+	}
+	return value, nil
+}
+
+// splitList splits the items of a flow list, ignoring commas inside quotes
+// and wikilinks
+func splitList(list string) []string {
+	var items []string
+	var quote byte
+	depth, start := 0, 0
+
+	for i := 0; i < len(list); i++ {
+		switch c := list[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			items = append(items, list[start:i])
+			start = i + 1
+		}
+	}
+	return append(items, list[start:])
+}
+
+// parseDate parses the date formats found in front matter
+func parseDate(value string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// unquote removes YAML single or double quotes around a string
+func unquote(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+	switch {
+	case s[0] == '"' && s[len(s)-1] == '"':
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+		return s[1 : len(s)-1]
+	case s[0] == '\'' && s[len(s)-1] == '\'':
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	return s
+}
+
+// stripComment removes a trailing " # comment" from an unquoted value
+func stripComment(value string) string {
+	if i := strings.Index(value, " #"); i >= 0 {
+		return strings.TrimSpace(value[:i])
+	}
+	return value
+}
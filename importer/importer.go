@@ -0,0 +1,605 @@
+// Package importer imports a vault of markdown notes, such as an Obsidian
+// vault, into a space.
+//
+// Every note becomes an object whose body is the markdown of the note. Front
+// matter keys are mapped to properties of the space, creating the missing
+// properties and select tags, and [[wikilinks]] are resolved into an objects
+// property once all notes have been created:
+//
+//	report, err := importer.Vault(ctx, client.Space(spaceID), "notes", importer.Options{})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(report)
+//	for _, entry := range report.Failed {
+//		fmt.Printf("%s: %v\n", entry.Path, entry.Err)
+//	}
+//
+// Notes whose name matches an existing object of the same type are skipped,
+// so an interrupted import can be run again.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+const (
+	// DefaultTypeKey is the type of the imported objects when Options.TypeKey is not set
+	DefaultTypeKey = "page"
+
+	// DefaultLinksProperty is the key of the objects property wikilinks are
+	// resolved into when Options.LinksProperty is not set
+	DefaultLinksProperty = "linked_notes"
+)
+
+// Options configures an import
+type Options struct {
+	// TypeKey is the type of the imported objects, DefaultTypeKey by default
+	TypeKey string
+
+	// LinksProperty is the key of the objects property holding the notes a
+	// note links to, DefaultLinksProperty by default. It is created when missing.
+	LinksProperty string
+
+	// Keys maps front matter keys to property keys. Other front matter keys
+	// are lowercased, with spaces and punctuation replaced by underscores.
+	Keys map[string]string
+
+	// Formats sets the format of the properties created for front matter keys,
+	// by property key. The format is inferred from the values otherwise.
+	Formats map[string]anytype.PropertyFormat
+
+	// TagColor is the color of the created tags, grey by default
+	TagColor anytype.Color
+}
+
+// Entry is the outcome of importing a note
+type Entry struct {
+	// Path is the path of the note, relative to the vault
+	Path string
+	// Name is the name of the object
+	Name string
+	// ObjectID is the ID of the created or existing object, empty when the
+	// object could not be created
+	ObjectID string
+	// Err is the reason a note failed
+	Err error
+}
+
+// UnresolvedLink is a wikilink to a note that is neither in the vault nor in the space
+type UnresolvedLink struct {
+	// Path is the path of the note containing the link
+	Path string
+	// Target is the link target
+	Target string
+}
+
+// Report lists what an import created, skipped or failed to import
+type Report struct {
+	// Created lists the notes imported as new objects
+	Created []Entry
+	// Skipped lists the notes matching an existing object
+	Skipped []Entry
+	// Failed lists the notes that could not be imported, or whose links could not be set
+	Failed []Entry
+
+	// Properties lists the keys of the created properties
+	Properties []string
+	// Tags lists the created tags, as "property key/tag name"
+	Tags []string
+	// Unresolved lists the wikilinks that could not be resolved
+	Unresolved []UnresolvedLink
+}
+
+// String returns a one line summary of the report
+func (r *Report) String() string {
+	return fmt.Sprintf("%d created, %d skipped, %d failed, %d properties and %d tags created, %d unresolved links",
+		len(r.Created), len(r.Skipped), len(r.Failed), len(r.Properties), len(r.Tags), len(r.Unresolved))
+}
+
+// note is a note being imported
+type note struct {
+	Entry
+	body    string
+	fields  []field
+	aliases []string
+	skipped bool
+
+	// links are the wikilink targets to resolve, by objects property key
+	links map[string][]string
+}
+
+// importer holds the state of an import
+type importer struct {
+	space  anytype.SpaceContext
+	opts   Options
+	report *Report
+
+	properties map[string]anytype.Property
+	// tags are the tag IDs of select properties, by property key and lowercase tag name
+	tags  map[string]map[string]string
+	index linkIndex
+}
+
+// Vault imports the markdown notes found under dir into the space. Hidden
+// directories, like .obsidian, are ignored.
+//
+// Failures to import a note are recorded in the report and do not stop the
+// import; an error is returned only when the vault or the space cannot be read.
+func Vault(ctx context.Context, space anytype.SpaceContext, dir string, opts Options) (*Report, error) {
+	if opts.TypeKey == "" {
+		opts.TypeKey = DefaultTypeKey
+	}
+	if opts.LinksProperty == "" {
+		opts.LinksProperty = DefaultLinksProperty
+	}
+	if opts.TagColor == "" {
+		opts.TagColor = anytype.ColorGrey
+	}
+
+	imp := &importer{
+		space:      space,
+		opts:       opts,
+		report:     &Report{},
+		properties: make(map[string]anytype.Property),
+		tags:       make(map[string]map[string]string),
+		index:      make(linkIndex),
+	}
+
+	for property, err := range space.Properties().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("importer: failed to list properties: %w", err)
+		}
+		imp.properties[property.Key] = property
+	}
+
+	existing := make(map[string]string)
+	for obj, err := range space.SearchAll(ctx, anytype.SearchRequest{Types: []string{opts.TypeKey}}) {
+		if err != nil {
+			return nil, fmt.Errorf("importer: failed to list objects: %w", err)
+		}
+		existing[strings.ToLower(obj.Name)] = obj.ID
+	}
+
+	notes, err := readVault(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// First pass: create the objects
+	for _, n := range notes {
+		if n.Err != nil {
+			continue
+		}
+
+		if id, ok := existing[strings.ToLower(n.Name)]; ok {
+			n.ObjectID = id
+			n.skipped = true
+			imp.index.add(id, n.Name, n.Path, n.aliases)
+			continue
+		}
+
+		if err := imp.create(ctx, n); err != nil {
+			n.Err = err
+			continue
+		}
+		imp.index.add(n.ObjectID, n.Name, n.Path, n.aliases)
+	}
+
+	// Second pass: resolve links now that every note has an object
+	for _, n := range notes {
+		if n.Err != nil || n.skipped || len(n.links) == 0 {
+			continue
+		}
+		n.Err = imp.link(ctx, n)
+	}
+
+	for _, n := range notes {
+		switch {
+		case n.Err != nil:
+			imp.report.Failed = append(imp.report.Failed, n.Entry)
+		case n.skipped:
+			imp.report.Skipped = append(imp.report.Skipped, n.Entry)
+		default:
+			imp.report.Created = append(imp.report.Created, n.Entry)
+		}
+	}
+
+	return imp.report, nil
+}
+
+// readVault reads and parses the notes under dir, in lexical order. Notes
+// that cannot be parsed are returned with Err set.
+func readVault(dir string) ([]*note, error) {
+	var notes []*note
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.EqualFold(filepath.Ext(path), ".md") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		n := &note{Entry: Entry{Path: filepath.ToSlash(rel)}}
+		notes = append(notes, n)
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			n.Err = err
+			return nil
+		}
+		n.Err = n.parse(string(content))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("importer: failed to read vault: %w", err)
+	}
+
+	return notes, nil
+}
+
+// parse reads the front matter and body of the note, and takes its name from
+// the title front matter key or the file name
+func (n *note) parse(content string) error {
+	fields, body, err := parseNote(content)
+	if err != nil {
+		return err
+	}
+
+	n.body = body
+	n.Name = strings.TrimSuffix(filepath.Base(n.Path), filepath.Ext(n.Path))
+
+	for _, f := range fields {
+		switch strings.ToLower(f.Key) {
+		case "title", "name":
+			if f.Value != nil {
+				n.Name = formatText(f.Value)
+			}
+		case "aliases", "alias":
+			n.aliases = append(n.aliases, toList(f.Value)...)
+		default:
+			n.fields = append(n.fields, f)
+		}
+	}
+	return nil
+}
+
+// create creates the object of a note, with the properties of its front
+// matter. Objects properties and wikilinks are recorded for the second pass.
+func (imp *importer) create(ctx context.Context, n *note) error {
+	n.links = make(map[string][]string)
+	if targets := wikilinks(n.body); len(targets) > 0 {
+		n.links[imp.opts.LinksProperty] = targets
+	}
+
+	var properties []anytype.PropertyLinkValue
+	for _, f := range n.fields {
+		if f.Value == nil {
+			continue
+		}
+
+		property, err := imp.property(ctx, f)
+		if err != nil {
+			return err
+		}
+
+		if anytype.PropertyFormat(property.Format) == anytype.PropertyFormatObjects {
+			for _, item := range toList(f.Value) {
+				if targets := wikilinks(item); len(targets) > 0 {
+					n.links[property.Key] = append(n.links[property.Key], targets...)
+				} else {
+					n.links[property.Key] = append(n.links[property.Key], item)
+				}
+			}
+			continue
+		}
+
+		value, err := imp.value(ctx, property, f.Value)
+		if err != nil {
+			return fmt.Errorf("front matter key %q: %w", f.Key, err)
+		}
+		properties = append(properties, value)
+	}
+
+	resp, err := imp.space.Objects().Create(ctx, anytype.CreateObjectRequest{
+		TypeKey:    imp.opts.TypeKey,
+		Name:       n.Name,
+		Body:       n.body,
+		Properties: properties,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create object: %w", err)
+	}
+	n.ObjectID = resp.Object.ID
+	return nil
+}
+
+// link sets the objects properties of a note to the objects its links resolve to
+func (imp *importer) link(ctx context.Context, n *note) error {
+	keys := make([]string, 0, len(n.links))
+	for key := range n.links {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var properties []anytype.PropertyLinkValue
+	for _, key := range keys {
+		targets := n.links[key]
+		var ids []string
+		for _, target := range targets {
+			id, ok := imp.index.resolve(target)
+			if !ok {
+				imp.report.Unresolved = append(imp.report.Unresolved, UnresolvedLink{Path: n.Path, Target: target})
+				continue
+			}
+			ids = append(ids, id)
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		if key == imp.opts.LinksProperty {
+			if err := imp.linksProperty(ctx); err != nil {
+				return err
+			}
+		}
+		properties = append(properties, anytype.ObjectsProperty(key, ids...))
+	}
+
+	if len(properties) == 0 {
+		return nil
+	}
+
+	err := imp.space.Object(n.ObjectID).Update(ctx, anytype.UpdateObjectRequest{Properties: properties})
+	if err != nil {
+		return fmt.Errorf("failed to set links: %w", err)
+	}
+	return nil
+}
+
+// linksProperty makes sure the property holding wikilinks exists
+func (imp *importer) linksProperty(ctx context.Context) error {
+	key := imp.opts.LinksProperty
+	if property, ok := imp.properties[key]; ok {
+		if anytype.PropertyFormat(property.Format) != anytype.PropertyFormatObjects {
+			return fmt.Errorf("links property %q has format %s, not objects", key, property.Format)
+		}
+		return nil
+	}
+
+	_, err := imp.createProperty(ctx, key, "Linked notes", anytype.PropertyFormatObjects)
+	return err
+}
+
+// property returns the property a front matter field maps to, creating it
+// when missing
+func (imp *importer) property(ctx context.Context, f field) (anytype.Property, error) {
+	key := imp.opts.Keys[f.Key]
+	if key == "" {
+		key = propertyKey(f.Key)
+	}
+	if key == "" {
+		return anytype.Property{}, fmt.Errorf("front matter key %q has no letters or digits to make a property key", f.Key)
+	}
+
+	if property, ok := imp.properties[key]; ok {
+		return property, nil
+	}
+
+	format, ok := imp.opts.Formats[key]
+	if !ok {
+		format = inferFormat(f.Value)
+	}
+	return imp.createProperty(ctx, key, f.Key, format)
+}
+
+// createProperty creates a property and records it in the report
+func (imp *importer) createProperty(ctx context.Context, key, name string, format anytype.PropertyFormat) (anytype.Property, error) {
+	resp, err := imp.space.Properties().Create(ctx, anytype.CreatePropertyRequest{
+		Key:    key,
+		Name:   name,
+		Format: string(format),
+	})
+	if err != nil {
+		return anytype.Property{}, fmt.Errorf("failed to create property %q: %w", key, err)
+	}
+
+	property := resp.Property
+	if property.Key == "" {
+		property.Key = key
+	}
+	if property.Format == "" {
+		property.Format = string(format)
+	}
+
+	imp.properties[key] = property
+	imp.tags[key] = make(map[string]string)
+	imp.report.Properties = append(imp.report.Properties, key)
+	return property, nil
+}
+
+// value converts a front matter value to a value of the property
+func (imp *importer) value(ctx context.Context, property anytype.Property, v any) (anytype.PropertyLinkValue, error) {
+	key := property.Key
+
+	switch anytype.PropertyFormat(property.Format) {
+	case anytype.PropertyFormatText:
+		return anytype.TextProperty(key, formatText(v)), nil
+	case anytype.PropertyFormatURL:
+		return anytype.URLProperty(key, formatText(v)), nil
+	case anytype.PropertyFormatEmail:
+		return anytype.EmailProperty(key, formatText(v)), nil
+	case anytype.PropertyFormatPhone:
+		return anytype.PhoneProperty(key, formatText(v)), nil
+	case anytype.PropertyFormatNumber:
+		switch v := v.(type) {
+		case float64:
+			return anytype.NumberProperty(key, v), nil
+		case string:
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				return anytype.NumberProperty(key, n), nil
+			}
+		}
+	case anytype.PropertyFormatCheckbox:
+		if v, ok := v.(bool); ok {
+			return anytype.CheckboxProperty(key, v), nil
+		}
+	case anytype.PropertyFormatDate:
+		switch v := v.(type) {
+		case time.Time:
+			return anytype.DateProperty(key, v), nil
+		case string:
+			if date, ok := parseDate(v); ok {
+				return anytype.DateProperty(key, date), nil
+			}
+		}
+	case anytype.PropertyFormatSelect:
+		names := toList(v)
+		if len(names) != 1 {
+			break
+		}
+		id, err := imp.tag(ctx, property, names[0])
+		if err != nil {
+			return anytype.PropertyLinkValue{}, err
+		}
+		return anytype.SelectProperty(key, id), nil
+	case anytype.PropertyFormatMultiSelect:
+		var ids []string
+		for _, name := range toList(v) {
+			id, err := imp.tag(ctx, property, name)
+			if err != nil {
+				return anytype.PropertyLinkValue{}, err
+			}
+			ids = append(ids, id)
+		}
+		return anytype.MultiSelectProperty(key, ids...), nil
+	}
+
+	return anytype.PropertyLinkValue{}, fmt.Errorf("cannot set %s property %q to %v", property.Format, key, v)
+}
+
+// tag returns the ID of a tag of a select property, creating it when missing
+func (imp *importer) tag(ctx context.Context, property anytype.Property, name string) (string, error) {
+	tags, ok := imp.tags[property.Key]
+	if !ok {
+		tags = make(map[string]string)
+		for tag, err := range imp.space.Property(property.ID).Tags().All(ctx) {
+			if err != nil {
+				return "", fmt.Errorf("failed to list tags of property %q: %w", property.Key, err)
+			}
+			tags[strings.ToLower(tag.Name)] = tag.ID
+		}
+		imp.tags[property.Key] = tags
+	}
+
+	if id, ok := tags[strings.ToLower(name)]; ok {
+		return id, nil
+	}
+
+	resp, err := imp.space.Property(property.ID).Tags().Create(ctx, anytype.CreateTagRequest{
+		Name:  name,
+		Color: imp.opts.TagColor,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create tag %q of property %q: %w", name, property.Key, err)
+	}
+
+	tags[strings.ToLower(name)] = resp.Tag.ID
+	imp.report.Tags = append(imp.report.Tags, property.Key+"/"+name)
+	return resp.Tag.ID, nil
+}
+
+// inferFormat returns the format of the property created for a front matter value
+func inferFormat(v any) anytype.PropertyFormat {
+	switch v := v.(type) {
+	case bool:
+		return anytype.PropertyFormatCheckbox
+	case float64:
+		return anytype.PropertyFormatNumber
+	case time.Time:
+		return anytype.PropertyFormatDate
+	case []string:
+		for _, item := range v {
+			if len(wikilinks(item)) == 0 {
+				return anytype.PropertyFormatMultiSelect
+			}
+		}
+		return anytype.PropertyFormatObjects
+	case string:
+		switch {
+		case len(wikilinks(v)) > 0:
+			return anytype.PropertyFormatObjects
+		case strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://"):
+			return anytype.PropertyFormatURL
+		}
+	}
+	return anytype.PropertyFormatText
+}
+
+// propertyKey turns a front matter key into a property key
+func propertyKey(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+// formatText returns a front matter value as text
+func formatText(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []string:
+		return strings.Join(v, ", ")
+	}
+	return fmt.Sprint(v)
+}
+
+// toList returns a front matter value as a list
+func toList(v any) []string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []string:
+		return v
+	}
+	return []string{formatText(v)}
+}
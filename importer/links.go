@@ -0,0 +1,53 @@
+package importer
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// wikilinkRe matches [[target]], [[target|alias]], [[target#heading]] and
+// their ![[embed]] variants
+var wikilinkRe = regexp.MustCompile(`!?\[\[([^\]|#]+)(?:#[^\]|]*)?(?:\|[^\]]*)?\]\]`)
+
+// wikilinks returns the distinct targets of the wikilinks in text, in order
+func wikilinks(text string) []string {
+	var targets []string
+	seen := make(map[string]bool)
+	for _, m := range wikilinkRe.FindAllStringSubmatch(text, -1) {
+		target := strings.TrimSpace(m[1])
+		if target != "" && !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// linkIndex resolves wikilink targets to object IDs
+type linkIndex map[string]string
+
+// add makes the object reachable by its name, the path of its note relative
+// to the vault, the base name of that path and its aliases
+func (idx linkIndex) add(objectID, name, notePath string, aliases []string) {
+	notePath = strings.TrimSuffix(notePath, path.Ext(notePath))
+	keys := append([]string{name, notePath, path.Base(notePath)}, aliases...)
+	for _, key := range keys {
+		key = linkKey(key)
+		if _, ok := idx[key]; key != "" && !ok {
+			idx[key] = objectID
+		}
+	}
+}
+
+// resolve returns the ID of the object a wikilink target points to
+func (idx linkIndex) resolve(target string) (string, bool) {
+	id, ok := idx[linkKey(target)]
+	return id, ok
+}
+
+// linkKey normalizes a note name or path for lookups
+func linkKey(s string) string {
+	s = strings.TrimSuffix(strings.TrimSpace(s), ".md")
+	return strings.ToLower(strings.TrimPrefix(s, "/"))
+}
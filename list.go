@@ -2,6 +2,9 @@ package anytype
 
 import (
 	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // ListClient provides operations on lists within a space
@@ -29,6 +32,9 @@ type ListContext interface {
 type ViewClient interface {
 	// List retrieves all views for the list
 	List(ctx context.Context) (*ViewListResponse, error)
+
+	// All returns an iterator over all views for the list, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[ListView, error]
 }
 
 // ViewContext provides operations on a specific view
@@ -56,6 +62,9 @@ type ObjectListContext interface {
 type ObjectViewClient interface {
 	// List returns all objects in the view
 	List(ctx context.Context) (*ObjectListResponse, error)
+
+	// All returns an iterator over all objects in the view, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Object, error]
 }
 
 // ListView represents a view configuration for a list
@@ -86,22 +95,12 @@ type ListSort struct {
 
 // ViewListResponse represents the paginated response for list views
 type ViewListResponse struct {
-	Data       []ListView `json:"data"`
-	Pagination struct {
-		Limit   int  `json:"limit"`
-		Offset  int  `json:"offset"`
-		Total   int  `json:"total"`
-		HasMore bool `json:"has_more"`
-	} `json:"pagination"`
+	Data       []ListView                 `json:"data"`
+	Pagination options.PaginationMetadata `json:"pagination"`
 }
 
 // ObjectListResponse represents the paginated response for objects in a list
 type ObjectListResponse struct {
-	Data       []Object `json:"data"`
-	Pagination struct {
-		Limit   int  `json:"limit"`
-		Offset  int  `json:"offset"`
-		Total   int  `json:"total"`
-		HasMore bool `json:"has_more"`
-	} `json:"pagination"`
+	Data       []Object                   `json:"data"`
+	Pagination options.PaginationMetadata `json:"pagination"`
 }
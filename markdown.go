@@ -0,0 +1,135 @@
+package anytype
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	listItemRe = regexp.MustCompile(`^([ \t]*)([-*+]|\d+[.)])\s+(\[([ xX])\]\s+)?(.*)$`)
+)
+
+// BlocksFromMarkdown splits a markdown document into text blocks in document
+// order. Headings, paragraphs, quotes, code fences and list items each become
+// a block; list items indented under another item are listed as its
+// children. Block IDs are positional ("block-1", "block-2", ...) and only
+// stable as long as the document is not edited.
+func BlocksFromMarkdown(markdown string) []Block {
+	var blocks []Block
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+
+	// Open list items, used to attach nested items to their parent
+	type listItem struct {
+		index  int
+		indent int
+	}
+	var lists []listItem
+
+	add := func(style, content string, source []string) int {
+		blocks = append(blocks, Block{
+			ID: fmt.Sprintf("block-%d", len(blocks)+1),
+			Text: &Text{
+				Content:  content,
+				Style:    style,
+				Markdown: strings.Join(source, "\n"),
+			},
+		})
+		return len(blocks) - 1
+	}
+
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			add(TextStyleParagraph, strings.Join(paragraph, "\n"), paragraph)
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" {
+			flush()
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "```") {
+			flush()
+			lists = nil
+
+			source := []string{line}
+			var code []string
+			for i++; i < len(lines); i++ {
+				source = append(source, lines[i])
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+					break
+				}
+				code = append(code, lines[i])
+			}
+			add(TextStyleCode, strings.Join(code, "\n"), source)
+			continue
+		}
+
+		if m := headingRe.FindStringSubmatch(trimmed); m != nil {
+			flush()
+			lists = nil
+
+			level := len(m[1])
+			if level > 4 {
+				level = 4
+			}
+			add(fmt.Sprintf("Header%d", level), m[2], []string{line})
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, ">") {
+			flush()
+			lists = nil
+
+			source := []string{line}
+			content := []string{strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))}
+			for i+1 < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i+1]), ">") {
+				i++
+				source = append(source, lines[i])
+				content = append(content, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")))
+			}
+			add(TextStyleQuote, strings.Join(content, "\n"), source)
+			continue
+		}
+
+		if m := listItemRe.FindStringSubmatch(line); m != nil {
+			flush()
+
+			indent := len(strings.ReplaceAll(m[1], "\t", "    "))
+			style := TextStyleMarked
+			switch {
+			case m[3] != "":
+				style = TextStyleCheckbox
+			case m[2][0] >= '0' && m[2][0] <= '9':
+				style = TextStyleNumbered
+			}
+
+			index := add(style, m[5], []string{line})
+			blocks[index].Text.Checked = m[4] == "x" || m[4] == "X"
+
+			for len(lists) > 0 && lists[len(lists)-1].indent >= indent {
+				lists = lists[:len(lists)-1]
+			}
+			if len(lists) > 0 {
+				parent := &blocks[lists[len(lists)-1].index]
+				parent.ChildrenIDs = append(parent.ChildrenIDs, blocks[index].ID)
+			}
+			lists = append(lists, listItem{index: index, indent: indent})
+			continue
+		}
+
+		lists = nil
+		paragraph = append(paragraph, trimmed)
+	}
+	flush()
+
+	return blocks
+}
@@ -2,12 +2,18 @@ package anytype
 
 import (
 	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // MemberClient provides operations on space members
 type MemberClient interface {
 	// List retrieves all members of the space
 	List(ctx context.Context) (*MemberListResponse, error)
+
+	// All returns an iterator over all members of the space, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Member, error]
 }
 
 // MemberContext provides operations on a specific member
@@ -29,7 +35,8 @@ type Member struct {
 
 // MemberListResponse represents the response from List members
 type MemberListResponse struct {
-	Data []Member `json:"data"`
+	Data       []Member                   `json:"data"`
+	Pagination options.PaginationMetadata `json:"pagination"`
 }
 
 // MemberResponse represents the response from Get member
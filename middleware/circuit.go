@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCircuitOpen is matched by the errors returned for requests rejected
+// without being sent because the circuit is open
+var ErrCircuitOpen = errors.New("circuit open")
+
+// CircuitState is the state of the circuit breaker of DisconnectMiddleware
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects requests until the reconnect delay has passed
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe request through, whose outcome
+	// closes or reopens the circuit
+	CircuitHalfOpen
+)
+
+// String returns the name of the state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitOpenError is returned for requests rejected because the circuit is
+// open. It matches ErrCircuitOpen with errors.Is.
+type CircuitOpenError struct {
+	// RetryAt is when the next probe request will be let through
+	RetryAt time.Time
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit open: server unreachable, next attempt in %v", time.Until(e.RetryAt).Round(time.Millisecond))
+}
+
+// Is reports whether target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net"
 	"net/http"
 	"net/url"
@@ -11,9 +13,16 @@ import (
 
 // DisconnectConfig configures the disconnect handling middleware
 type DisconnectConfig struct {
-	// ReconnectDelay is the delay before attempting to reconnect
+	// FailureThreshold is the number of consecutive network failures that open
+	// the circuit, rejecting requests without sending them. Zero leaves the
+	// circuit closed, only tracking the connection.
+	FailureThreshold int
+	// ReconnectDelay is the delay before attempting to reconnect, by letting
+	// a probe request through an open circuit
 	ReconnectDelay time.Duration
-	// MaxReconnectAttempts is the maximum number of reconnect attempts
+	// MaxReconnectAttempts caps the number of failed reconnect attempts that
+	// double ReconnectDelay before the next one. Past MaxReconnectShift
+	// attempts the delay stops growing.
 	MaxReconnectAttempts int
 	// OnDisconnect is called when a disconnection is detected
 	OnDisconnect func()
@@ -21,21 +30,35 @@ type DisconnectConfig struct {
 	OnReconnect func()
 }
 
+// MaxReconnectShift is the maximum number of times ReconnectDelay is
+// doubled, keeping the delay from overflowing
+const MaxReconnectShift = 16
+
 // DefaultDisconnectConfig provides sensible defaults for disconnect configuration
 func DefaultDisconnectConfig() DisconnectConfig {
 	return DisconnectConfig{
+		FailureThreshold:     3,
 		ReconnectDelay:       5 * time.Second,
 		MaxReconnectAttempts: 5,
 	}
 }
 
-// DisconnectMiddleware handles temporary network disconnections
+// DisconnectMiddleware handles temporary network disconnections with a
+// circuit breaker. After FailureThreshold consecutive network failures the
+// circuit opens and requests fail fast with a CircuitOpenError. Once
+// ReconnectDelay has passed, a single probe request is let through: its
+// success closes the circuit, its failure reopens it for a longer delay.
 type DisconnectMiddleware struct {
 	Next   HTTPDoer
 	Config DisconnectConfig
 
 	connected bool
 	mu        sync.RWMutex
+
+	state    CircuitState
+	failures int
+	attempts int
+	retryAt  time.Time
 }
 
 // NewDisconnectMiddleware creates a new disconnect handling middleware
@@ -47,15 +70,29 @@ func NewDisconnectMiddleware(next HTTPDoer, config DisconnectConfig) *Disconnect
 	}
 }
 
+// WithDisconnect returns a middleware function that applies disconnect handling with the given configuration
+func WithDisconnect(config DisconnectConfig) func(HTTPDoer) HTTPDoer {
+	return func(next HTTPDoer) HTTPDoer {
+		return NewDisconnectMiddleware(next, config)
+	}
+}
+
 // Do executes an HTTP request with disconnect handling
 func (m *DisconnectMiddleware) Do(req *http.Request) (*http.Response, error) {
+	probe, err := m.admit()
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := m.Next.Do(req)
 
 	// Check if this is a network-related error
 	if m.isNetworkError(err) {
+		m.recordFailure(probe)
 		m.handleDisconnect()
 		return resp, err
 	}
+	m.recordResult(probe, err == nil)
 
 	// If we were previously disconnected, but now succeeded
 	// mark as reconnected
@@ -76,6 +113,12 @@ func (m *DisconnectMiddleware) isNetworkError(err error) bool {
 		return false
 	}
 
+	// Cancelled and timed out contexts implement net.Error, but are decided
+	// by the caller rather than the server
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
 	// Check if it's directly a network error
 	if _, ok := err.(net.Error); ok {
 		return true
@@ -98,6 +141,73 @@ func (m *DisconnectMiddleware) isNetworkError(err error) bool {
 		strings.Contains(errStr, "connection reset by peer")
 }
 
+// admit decides whether a request can be sent, reporting whether it is the
+// probe of a half-open circuit
+func (m *DisconnectMiddleware) admit() (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch m.state {
+	case CircuitOpen:
+		if time.Now().Before(m.retryAt) {
+			return false, &CircuitOpenError{RetryAt: m.retryAt}
+		}
+		m.state = CircuitHalfOpen
+		return true, nil
+	case CircuitHalfOpen:
+		// A probe is in flight
+		return false, &CircuitOpenError{RetryAt: time.Now().Add(m.Config.ReconnectDelay)}
+	}
+	return false, nil
+}
+
+// recordFailure records a network failure, opening the circuit when the
+// threshold is reached or the probe failed
+func (m *DisconnectMiddleware) recordFailure(probe bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Config.FailureThreshold <= 0 {
+		return
+	}
+
+	m.failures++
+	if !probe && (m.state != CircuitClosed || m.failures < m.Config.FailureThreshold) {
+		return
+	}
+
+	if probe && m.attempts < m.Config.MaxReconnectAttempts {
+		m.attempts++
+	}
+	m.state = CircuitOpen
+	m.retryAt = time.Now().Add(m.Config.ReconnectDelay << min(m.attempts, MaxReconnectShift))
+}
+
+// recordResult records a request that reached the server, or failed
+// otherwise. Successes close the circuit; other failures of a probe let
+// another probe through.
+func (m *DisconnectMiddleware) recordResult(probe, success bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if success {
+		m.state = CircuitClosed
+		m.failures = 0
+		m.attempts = 0
+		return
+	}
+	if probe {
+		m.state = CircuitOpen
+	}
+}
+
+// State returns the state of the circuit, for health checks
+func (m *DisconnectMiddleware) State() CircuitState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state
+}
+
 // handleDisconnect handles a disconnection event
 func (m *DisconnectMiddleware) handleDisconnect() {
 	m.mu.Lock()
@@ -0,0 +1,221 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Redacted replaces the secrets in logged headers and bodies
+const Redacted = "[REDACTED]"
+
+// DefaultRedactFields are the JSON fields always redacted from logged bodies:
+// the app key returned by authentication and the code it is created from
+var DefaultRedactFields = []string{"api_key", "app_key", "code"}
+
+// LoggingConfig configures the logging middleware
+type LoggingConfig struct {
+	// Logger receives a record per request, slog.Default() when nil
+	Logger *slog.Logger
+	// Level is the level of the records of successful requests. Failed
+	// requests and error responses are logged at slog.LevelWarn.
+	Level slog.Level
+	// LogHeaders adds the request headers to the records, with the
+	// Authorization key redacted
+	LogHeaders bool
+	// LogBodies adds the request and response bodies to the records, with
+	// the DefaultRedactFields and RedactFields of JSON bodies redacted
+	LogBodies bool
+	// MaxBodySize truncates logged bodies to this number of bytes, 0 meaning
+	// no limit
+	MaxBodySize int
+	// RedactFields are JSON fields redacted from logged bodies at any depth,
+	// in addition to DefaultRedactFields
+	RedactFields []string
+}
+
+// DefaultLoggingConfig provides sensible defaults for logging configuration
+func DefaultLoggingConfig() LoggingConfig {
+	return LoggingConfig{
+		Level:       slog.LevelInfo,
+		MaxBodySize: 4096,
+	}
+}
+
+// LoggingMiddleware emits a structured log record for every request
+type LoggingMiddleware struct {
+	Next   HTTPDoer
+	Config LoggingConfig
+}
+
+// NewLoggingMiddleware creates a new logging middleware with the given configuration
+func NewLoggingMiddleware(next HTTPDoer, config LoggingConfig) *LoggingMiddleware {
+	return &LoggingMiddleware{
+		Next:   next,
+		Config: config,
+	}
+}
+
+// WithLogging returns a middleware function that logs requests with the given configuration
+func WithLogging(config LoggingConfig) func(HTTPDoer) HTTPDoer {
+	return func(next HTTPDoer) HTTPDoer {
+		return NewLoggingMiddleware(next, config)
+	}
+}
+
+// Do executes an HTTP request and logs its outcome
+func (m *LoggingMiddleware) Do(req *http.Request) (*http.Response, error) {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", PathTemplate(req.URL.Path)),
+		slog.Int("attempt", Attempt(req.Context())),
+	}
+	if m.Config.LogHeaders {
+		attrs = append(attrs, m.headers(req.Header))
+	}
+	if m.Config.LogBodies && req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		attrs = append(attrs, slog.String("request_body", m.body(body)))
+	}
+
+	start := time.Now()
+	resp, err := m.Next.Do(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	level := m.Config.Level
+	if err != nil {
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	if resp != nil {
+		if resp.StatusCode >= 400 {
+			level = slog.LevelWarn
+		}
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+		// Buffer the response to measure it, the client reads it whole anyway
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			err = readErr
+		}
+		attrs = append(attrs, slog.Int("response_size", len(body)))
+		if m.Config.LogBodies {
+			attrs = append(attrs, slog.String("response_body", m.body(body)))
+		}
+	}
+
+	m.logger().LogAttrs(req.Context(), level, "anytype request", attrs...)
+	return resp, err
+}
+
+// logger returns the configured logger
+func (m *LoggingMiddleware) logger() *slog.Logger {
+	if m.Config.Logger != nil {
+		return m.Config.Logger
+	}
+	return slog.Default()
+}
+
+// headers returns the request headers as a group, redacting credentials
+func (m *LoggingMiddleware) headers(header http.Header) slog.Attr {
+	var attrs []any
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		if strings.EqualFold(name, "Authorization") {
+			scheme, _, _ := strings.Cut(value, " ")
+			value = strings.TrimSpace(scheme + " " + Redacted)
+		}
+		attrs = append(attrs, slog.String(name, value))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+// body returns a body as logged: redacted when JSON, then truncated
+func (m *LoggingMiddleware) body(body []byte) string {
+	var value any
+	if json.Unmarshal(body, &value) == nil {
+		fields := append(append([]string{}, DefaultRedactFields...), m.Config.RedactFields...)
+		if redacted, err := json.Marshal(redact(value, fields)); err == nil {
+			body = redacted
+		}
+	}
+
+	if m.Config.MaxBodySize > 0 && len(body) > m.Config.MaxBodySize {
+		return string(body[:m.Config.MaxBodySize]) + "..."
+	}
+	return string(body)
+}
+
+// redact replaces the values of the given fields in a decoded JSON value
+func redact(value any, fields []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			v[key] = redact(field, fields)
+			for _, name := range fields {
+				if key == name {
+					v[key] = Redacted
+				}
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redact(item, fields)
+		}
+	}
+	return value
+}
+
+// pathParams maps the collections of the API to the name of their ID parameter
+var pathParams = map[string]string{
+	"spaces":     "{space_id}",
+	"objects":    "{object_id}",
+	"types":      "{type_id}",
+	"templates":  "{template_id}",
+	"properties": "{property_id}",
+	"tags":       "{tag_id}",
+	"lists":      "{list_id}",
+	"views":      "{view_id}",
+	"members":    "{member_id}",
+}
+
+// PathTemplate returns a request path with its IDs replaced by parameter
+// names, e.g. /v1/spaces/{space_id}/objects/{object_id}, so that log records
+// of requests to the same endpoint can be grouped
+func PathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		if param, ok := pathParams[segments[i-1]]; ok && segments[i] != "" {
+			segments[i] = param
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+type attemptKey struct{}
+
+// withAttempt returns a context carrying the attempt number of a request
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// Attempt returns the attempt number of the request with the given context,
+// set by the retry middleware: 1 for the first attempt, 2 for the first retry
+func Attempt(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig configures the rate limit middleware
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate. Zero or less disables
+	// proactive throttling, leaving only the server's rate limit headers honoured.
+	RequestsPerSecond float64
+	// Burst is the number of requests that can be sent at once before being
+	// throttled to RequestsPerSecond
+	Burst int
+	// MaxRetryAfter caps the pause requested by a server through the
+	// Retry-After and X-RateLimit-Reset headers
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRateLimitConfig provides sensible defaults for rate limit configuration
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		RequestsPerSecond: 10,
+		Burst:             20,
+		MaxRetryAfter:     time.Minute,
+	}
+}
+
+// RateLimitMiddleware throttles requests with a token bucket. The bucket is
+// shared by all the requests going through the middleware, whatever their
+// goroutine.
+//
+// When a response is a 429 with a Retry-After header, or reports an exhausted
+// budget with X-RateLimit-Remaining and X-RateLimit-Reset, requests are held
+// until the server accepts them again.
+type RateLimitMiddleware struct {
+	Next   HTTPDoer
+	Config RateLimitConfig
+
+	mu     sync.Mutex
+	tokens float64
+	// last is the time tokens were last refilled, or the end of a pause
+	// requested by the server when in the future
+	last time.Time
+}
+
+// NewRateLimitMiddleware creates a new rate limit middleware with the given configuration
+func NewRateLimitMiddleware(next HTTPDoer, config RateLimitConfig) *RateLimitMiddleware {
+	if config.Burst < 1 {
+		config.Burst = 1
+	}
+	return &RateLimitMiddleware{
+		Next:   next,
+		Config: config,
+		tokens: float64(config.Burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimit returns a middleware function that applies rate limiting with
+// the given configuration. Every client built with it gets its own budget.
+func WithRateLimit(config RateLimitConfig) func(HTTPDoer) HTTPDoer {
+	return func(next HTTPDoer) HTTPDoer {
+		return NewRateLimitMiddleware(next, config)
+	}
+}
+
+// Do executes an HTTP request once the rate limit allows it
+func (m *RateLimitMiddleware) Do(req *http.Request) (*http.Response, error) {
+	// Wait for a token, then for any pause requested by the server meanwhile
+	for wait := m.reserve(); wait > 0; wait = m.paused() {
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			m.release()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	resp, err := m.Next.Do(req)
+	if resp != nil {
+		if pause := m.serverPause(resp); pause > 0 {
+			m.pause(pause)
+		}
+	}
+	return resp, err
+}
+
+// reserve takes a token from the bucket and returns how long to wait before using it
+func (m *RateLimitMiddleware) reserve() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var wait time.Duration
+	if m.last.After(now) {
+		wait = m.last.Sub(now)
+	}
+	if m.Config.RequestsPerSecond <= 0 {
+		return wait
+	}
+
+	m.refill(now)
+	m.tokens--
+	if m.tokens < 0 {
+		wait += time.Duration(-m.tokens / m.Config.RequestsPerSecond * float64(time.Second))
+	}
+	return wait
+}
+
+// release returns the token taken by reserve for a request that was not sent
+func (m *RateLimitMiddleware) release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Config.RequestsPerSecond <= 0 {
+		return
+	}
+	m.refill(time.Now())
+	if m.tokens++; m.tokens > float64(m.Config.Burst) {
+		m.tokens = float64(m.Config.Burst)
+	}
+}
+
+// refill adds the tokens earned since the last refill. It must be called
+// with the lock held.
+func (m *RateLimitMiddleware) refill(now time.Time) {
+	if !now.After(m.last) {
+		return
+	}
+	m.tokens += now.Sub(m.last).Seconds() * m.Config.RequestsPerSecond
+	if burst := float64(m.Config.Burst); m.tokens > burst {
+		m.tokens = burst
+	}
+	m.last = now
+}
+
+// paused returns the time left in a pause requested by the server
+func (m *RateLimitMiddleware) paused() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if wait := time.Until(m.last); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// pause holds requests for d, emptying the bucket so that they resume at the
+// configured rate rather than in a burst
+func (m *RateLimitMiddleware) pause(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.refill(now)
+	if until := now.Add(d); until.After(m.last) {
+		m.last = until
+		if m.tokens > 0 {
+			m.tokens = 0
+		}
+	}
+}
+
+// serverPause returns the pause requested by the rate limit headers of a response
+func (m *RateLimitMiddleware) serverPause(resp *http.Response) time.Duration {
+	var pause time.Duration
+	if resp.StatusCode == http.StatusTooManyRequests {
+		pause = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+	if pause == 0 && resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		pause = parseRateLimitReset(resp.Header.Get("X-RateLimit-Reset"))
+	}
+
+	if m.Config.MaxRetryAfter > 0 && pause > m.Config.MaxRetryAfter {
+		pause = m.Config.MaxRetryAfter
+	}
+	return pause
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// parseRateLimitReset parses an X-RateLimit-Reset header, given either as a
+// unix timestamp or as seconds until the reset
+func parseRateLimitReset(value string) time.Duration {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	// Values larger than a year of seconds are timestamps
+	if seconds > 365*24*60*60 {
+		return time.Until(time.Unix(seconds, 0))
+	}
+	return time.Duration(seconds) * time.Second
+}
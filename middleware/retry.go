@@ -2,11 +2,29 @@ package middleware
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"sync"
 	"time"
 )
 
+// JitterStrategy randomizes the delay between retries, so that clients
+// failing together do not retry in lockstep
+type JitterStrategy string
+
+const (
+	// JitterNone uses the exponential backoff delay as is
+	JitterNone JitterStrategy = ""
+	// JitterFull picks a delay between zero and the exponential backoff delay
+	JitterFull JitterStrategy = "full"
+	// JitterDecorrelated picks a delay between RetryDelay and three times the
+	// previous delay
+	JitterDecorrelated JitterStrategy = "decorrelated"
+)
+
 // RetryConfig configures the retry middleware
 type RetryConfig struct {
 	// MaxRetries is the maximum number of retries
@@ -15,10 +33,48 @@ type RetryConfig struct {
 	RetryDelay time.Duration
 	// MaxRetryDelay is the maximum delay between retries
 	MaxRetryDelay time.Duration
+	// Jitter randomizes the delay between retries
+	Jitter JitterStrategy
+	// Budget limits the retries of all the requests going through the
+	// middleware. Nil allows every request MaxRetries retries.
+	Budget *RetryBudget
+	// RetryNonIdempotent retries POST and PATCH requests like the others. By
+	// default they are only retried when the server did not receive them:
+	// on connection failures and 429 responses. Requests carrying an
+	// Idempotency-Key header are always retried.
+	RetryNonIdempotent bool
 	// RetryableStatusCodes defines HTTP status codes that should trigger a retry
 	RetryableStatusCodes []int
 	// ShouldRetry is a custom function to determine if a request should be retried
 	ShouldRetry func(*http.Response, error) bool
+	// OnRetry is called before each retry
+	OnRetry func(RetryEvent)
+}
+
+// RetryBudget limits retries across requests, so that a failing server is
+// not flooded with them. Each request earns Ratio retries and each retry
+// spends one.
+type RetryBudget struct {
+	// Ratio is the number of retries earned by each request, e.g. 0.2 allows
+	// one retry for five requests
+	Ratio float64
+	// MaxRetries is the number of retries that can be saved up, available
+	// from the start
+	MaxRetries int
+}
+
+// RetryEvent describes a retry about to happen
+type RetryEvent struct {
+	// Request is the retried request
+	Request *http.Request
+	// Attempt is the number of the retry, starting at 1
+	Attempt int
+	// Response is the response of the failed attempt, nil on errors
+	Response *http.Response
+	// Err is the error of the failed attempt
+	Err error
+	// Delay is the time waited before the retry
+	Delay time.Duration
 }
 
 // DefaultRetryConfig provides sensible defaults for retry configuration
@@ -27,6 +83,8 @@ func DefaultRetryConfig() RetryConfig {
 		MaxRetries:    5,
 		RetryDelay:    200 * time.Millisecond,
 		MaxRetryDelay: 30 * time.Second,
+		Jitter:        JitterFull,
+		Budget:        &RetryBudget{Ratio: 0.2, MaxRetries: 10},
 		ShouldRetry:   defaultShouldRetry,
 		RetryableStatusCodes: []int{
 			http.StatusRequestTimeout,
@@ -43,14 +101,21 @@ func DefaultRetryConfig() RetryConfig {
 type RetryMiddleware struct {
 	Next   HTTPDoer
 	Config RetryConfig
+
+	mu     sync.Mutex
+	budget float64
 }
 
 // NewRetryMiddleware creates a new retry middleware with the given configuration
 func NewRetryMiddleware(next HTTPDoer, config RetryConfig) *RetryMiddleware {
-	return &RetryMiddleware{
+	m := &RetryMiddleware{
 		Next:   next,
 		Config: config,
 	}
+	if config.Budget != nil {
+		m.budget = float64(config.Budget.MaxRetries)
+	}
+	return m
 }
 
 // Do executes an HTTP request with retries according to the retry policy
@@ -68,13 +133,18 @@ func (m *RetryMiddleware) Do(req *http.Request) (*http.Response, error) {
 	}
 
 	// Try the initial request
+	m.earnRetries()
 	resp, err = m.Next.Do(req)
 
 	// Retry loop
 	retries := 0
-	for retries < m.Config.MaxRetries && m.shouldRetry(resp, err) {
-		// Delay before retry with exponential backoff
-		delay := exponentialBackoff(m.Config.RetryDelay, retries, m.Config.MaxRetryDelay)
+	var delay time.Duration
+	for retries < m.Config.MaxRetries && m.shouldRetry(req, resp, err) && m.spendRetry() {
+		delay = m.backoff(retries, delay)
+		if m.Config.OnRetry != nil {
+			m.Config.OnRetry(RetryEvent{Request: req, Attempt: retries + 1, Response: resp, Err: err, Delay: delay})
+		}
+
 		select {
 		case <-req.Context().Done():
 			// Context was canceled
@@ -83,12 +153,18 @@ func (m *RetryMiddleware) Do(req *http.Request) (*http.Response, error) {
 			// Continue with retry
 		}
 
-		// Clone the request to ensure it's fresh for retry
-		retryReq, cloneErr := cloneRequest(req, bodyCloner)
+		// Clone the request to ensure it's fresh for retry, numbering the attempt
+		retryReq, cloneErr := cloneRequest(req.WithContext(withAttempt(req.Context(), retries+2)), bodyCloner)
 		if cloneErr != nil {
 			return resp, cloneErr
 		}
 
+		// Discard the failed attempt
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
 		// Execute the retry
 		resp, err = m.Next.Do(retryReq)
 		retries++
@@ -98,18 +174,99 @@ func (m *RetryMiddleware) Do(req *http.Request) (*http.Response, error) {
 }
 
 // shouldRetry determines if a request should be retried based on the response and error
-func (m *RetryMiddleware) shouldRetry(resp *http.Response, err error) bool {
+func (m *RetryMiddleware) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if !m.Config.RetryNonIdempotent && !isIdempotent(req) && !notReceived(resp, err) {
+		return false
+	}
 	if m.Config.ShouldRetry != nil {
 		return m.Config.ShouldRetry(resp, err)
 	}
 	return defaultShouldRetry(resp, err)
 }
 
+// earnRetries credits the retry budget for a request
+func (m *RetryMiddleware) earnRetries() {
+	if m.Config.Budget == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.budget += m.Config.Budget.Ratio
+	if limit := float64(m.Config.Budget.MaxRetries); m.budget > limit {
+		m.budget = limit
+	}
+}
+
+// spendRetry takes a retry from the budget, reporting whether one was left
+func (m *RetryMiddleware) spendRetry() bool {
+	if m.Config.Budget == nil {
+		return true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.budget < 1 {
+		return false
+	}
+	m.budget--
+	return true
+}
+
+// backoff returns the delay before a retry, given the previous delay
+func (m *RetryMiddleware) backoff(retry int, previous time.Duration) time.Duration {
+	switch m.Config.Jitter {
+	case JitterFull:
+		return randomDelay(0, exponentialBackoff(m.Config.RetryDelay, retry, m.Config.MaxRetryDelay))
+	case JitterDecorrelated:
+		delay := randomDelay(m.Config.RetryDelay, max(previous*3, m.Config.RetryDelay))
+		if delay > m.Config.MaxRetryDelay {
+			delay = m.Config.MaxRetryDelay
+		}
+		return delay
+	}
+	return exponentialBackoff(m.Config.RetryDelay, retry, m.Config.MaxRetryDelay)
+}
+
+// randomDelay returns a random delay between lo and hi
+func randomDelay(lo, hi time.Duration) time.Duration {
+	if hi <= lo {
+		return lo
+	}
+	return lo + rand.N(hi-lo+1)
+}
+
+// isIdempotent reports whether sending a request twice has the same effect as sending it once
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch:
+		return req.Header.Get("Idempotency-Key") != ""
+	}
+	return true
+}
+
+// notReceived reports whether a failed attempt is known not to have been
+// processed by the server: the connection could not be established, or the
+// server rejected the request because of rate limiting
+func notReceived(resp *http.Response, err error) bool {
+	if err == nil {
+		return resp != nil && resp.StatusCode == http.StatusTooManyRequests
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
 // defaultShouldRetry provides default retry logic
 func defaultShouldRetry(resp *http.Response, err error) bool {
-	// Retry on connection errors
+	// Retry on connection errors, unless the circuit is open: the request
+	// would be rejected again until the reconnect delay has passed
 	if err != nil {
-		return true
+		return !errors.Is(err, ErrCircuitOpen)
 	}
 
 	// Retry on specific status codes
@@ -133,7 +290,8 @@ func WithRetry() func(HTTPDoer) HTTPDoer {
 	return WithCustomRetry(DefaultRetryConfig())
 }
 
-// WithCustomRetry returns a middleware function that applies retry logic with custom configuration
+// WithCustomRetry returns a middleware function that applies retry logic with
+// custom configuration. Every client built with it gets its own retry budget.
 func WithCustomRetry(config RetryConfig) func(HTTPDoer) HTTPDoer {
 	return func(next HTTPDoer) HTTPDoer {
 		return NewRetryMiddleware(next, config)
@@ -36,6 +36,13 @@ func (m *ValidationMiddleware) Do(req *http.Request) (*http.Response, error) {
 	return m.Next.Do(req)
 }
 
+// WithValidation returns a middleware function that validates requests
+func WithValidation() func(HTTPDoer) HTTPDoer {
+	return func(next HTTPDoer) HTTPDoer {
+		return NewValidationMiddleware(next)
+	}
+}
+
 type contextKey string
 
 const validatorKey contextKey = "validator"
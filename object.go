@@ -2,6 +2,7 @@ package anytype
 
 import (
 	"context"
+	"iter"
 
 	"github.com/rubiojr/anytype-go/options"
 )
@@ -11,8 +12,18 @@ type ObjectClient interface {
 	// List returns all objects in the space
 	List(ctx context.Context, opts ...options.ListOption) ([]Object, error)
 
+	// All returns an iterator over all objects in the space, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Object, error]
+
 	// Create creates a new object in the space
 	Create(ctx context.Context, request CreateObjectRequest) (*ObjectResponse, error)
+
+	// Upsert updates the object of the request type matching the key, or
+	// creates it when none matches. It returns an *UpsertConflictError when
+	// several objects match, and an error wrapping ErrUnsupported when the
+	// request sets a body and an object matches, as the API cannot update
+	// the body of an existing object.
+	Upsert(ctx context.Context, key UpsertKey, request CreateObjectRequest) (*UpsertResponse, error)
 }
 
 // ObjectContext provides operations on a specific object
@@ -26,23 +37,27 @@ type ObjectContext interface {
 	// Delete deletes the object
 	Delete(ctx context.Context) (*ObjectResponse, error)
 
-	// Export exports the object in the specified format
-	Export(ctx context.Context, format string) (*ExportResult, error)
+	// Export exports the object in the specified format. Formats without a
+	// registered exporter return an error wrapping ErrUnsupported.
+	Export(ctx context.Context, format ExportFormat) (*ExportResult, error)
+
+	// Blocks returns a BlockClient for the content of this object
+	Blocks() BlockClient
 }
 
 // Object represents an Anytype object
 type Object struct {
-	ID         string
-	Name       string
-	SpaceID    string `json:"space_id"`
-	TypeKey    string
-	Layout     string
-	Archived   bool
-	Icon       *Icon
-	Snippet    string
-	Properties []Property
-	Type       *Type  `json:"type,omitempty"`
-	Markdown   string `json:"markdown,omitempty"` // Content in markdown format when requested with format=md
+	ID         string              `json:"id"`
+	Name       string              `json:"name"`
+	SpaceID    string              `json:"space_id"`
+	TypeKey    string              `json:"type_key,omitempty"`
+	Layout     string              `json:"layout"`
+	Archived   bool                `json:"archived"`
+	Icon       *Icon               `json:"icon,omitempty"`
+	Snippet    string              `json:"snippet"`
+	Properties []PropertyWithValue `json:"properties"`
+	Type       *Type               `json:"type,omitempty"`
+	Markdown   string              `json:"markdown,omitempty"` // Content in markdown format when requested with format=md
 }
 
 // ObjectResponse wraps an Object in a response according to the API specification
@@ -56,15 +71,16 @@ type CreateObjectRequest struct {
 	Name       string
 	Body       string
 	Icon       *Icon
-	TemplateID string           `json:"template_id,omitempty"`
-	Properties []map[string]any `json:"properties"`
+	TemplateID string              `json:"template_id,omitempty"`
+	Properties []PropertyLinkValue `json:"properties"`
 }
 
-// UpdateObjectRequest contains parameters for updating an object
+// UpdateObjectRequest contains parameters for updating an object. The body of
+// an object can only be set when creating it.
 type UpdateObjectRequest struct {
-	Name       string           `json:"name,omitempty"`
-	Icon       *Icon            `json:"icon,omitempty"`
-	Properties []map[string]any `json:"properties,omitempty"`
+	Name       string              `json:"name,omitempty"`
+	Icon       *Icon               `json:"icon,omitempty"`
+	Properties []PropertyLinkValue `json:"properties,omitempty"`
 }
 
 // IconFormat represents the type of icon
@@ -79,6 +95,15 @@ const (
 	IconFormatIcon IconFormat = "icon"
 )
 
+// IsValid reports whether the icon format is one of the formats supported by the API
+func (f IconFormat) IsValid() bool {
+	switch f {
+	case IconFormatEmoji, IconFormatFile, IconFormatIcon:
+		return true
+	}
+	return false
+}
+
 // Icon represents an object icon
 type Icon struct {
 	Format IconFormat `json:"format,omitempty"` // Type of icon: emoji, file, or icon
@@ -45,7 +45,7 @@ type Tag struct {
 	ID     string `json:"id,omitempty"`
 	Key    string `json:"key,omitempty"` // Added key field from API definition
 	Name   string `json:"name,omitempty"`
-	Color  string `json:"color,omitempty"`
+	Color  Color  `json:"color,omitempty"`
 	Object string `json:"object,omitempty"` // Data model identifier
 }
 
@@ -64,7 +64,32 @@ type CreatePropertyRequest struct {
 	Format string `json:"format"`
 }
 
+// UpdatePropertyRequest contains parameters for updating a property
+type UpdatePropertyRequest struct {
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name"`
+}
+
 // PropertyResponse represents the response from creating or getting a property
 type PropertyResponse struct {
 	Property Property `json:"property"`
 }
+
+// readOnlyProperties lists the system properties maintained by Anytype that
+// cannot be set through the API
+var readOnlyProperties = map[string]bool{
+	"added_date":         true,
+	"backlinks":          true,
+	"created_date":       true,
+	"creator":            true,
+	"last_modified_by":   true,
+	"last_modified_date": true,
+	"last_opened_date":   true,
+	"links":              true,
+}
+
+// IsReadOnlyProperty reports whether the property with the given key is
+// maintained by Anytype and cannot be set through the API
+func IsReadOnlyProperty(key string) bool {
+	return readOnlyProperties[key]
+}
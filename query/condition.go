@@ -0,0 +1,244 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// Operator is the condition of a filter in the API filter model
+type Operator = anytype.FilterCondition
+
+const (
+	Equal          = anytype.FilterConditionEqual
+	NotEqual       = anytype.FilterConditionNotEqual
+	Greater        = anytype.FilterConditionGreater
+	Less           = anytype.FilterConditionLess
+	GreaterOrEqual = anytype.FilterConditionGreaterOrEqual
+	LessOrEqual    = anytype.FilterConditionLessOrEqual
+	Like           = anytype.FilterConditionLike
+	NotLike        = anytype.FilterConditionNotLike
+	In             = anytype.FilterConditionIn
+	NotIn          = anytype.FilterConditionNotIn
+	Empty          = anytype.FilterConditionEmpty
+	NotEmpty       = anytype.FilterConditionNotEmpty
+	AllIn          = anytype.FilterConditionAllIn
+	NotAllIn       = anytype.FilterConditionNotAllIn
+	ExactIn        = anytype.FilterConditionExactIn
+	NotExactIn     = anytype.FilterConditionNotExactIn
+	Exists         = anytype.FilterConditionExists
+)
+
+// negations maps operators to their opposite
+var negations = map[Operator]Operator{
+	Equal:          NotEqual,
+	NotEqual:       Equal,
+	Greater:        LessOrEqual,
+	LessOrEqual:    Greater,
+	Less:           GreaterOrEqual,
+	GreaterOrEqual: Less,
+	Like:           NotLike,
+	NotLike:        Like,
+	In:             NotIn,
+	NotIn:          In,
+	Empty:          NotEmpty,
+	NotEmpty:       Empty,
+	AllIn:          NotAllIn,
+	NotAllIn:       AllIn,
+	ExactIn:        NotExactIn,
+	NotExactIn:     ExactIn,
+}
+
+// Condition is a condition on a single property. Value is a string, float64,
+// bool, time.Time or []string, and is unused by Empty, NotEmpty and Exists.
+//
+// Besides property keys, PropertyKey accepts "name", "id" and "type", which
+// refer to the name, ID and type key of the object.
+//
+// Format is the property format sent in the API filter model. When empty, it
+// is implied by the type of Value.
+type Condition struct {
+	PropertyKey string
+	Operator    Operator
+	Value       any
+	Format      anytype.PropertyFormat
+}
+
+// Field builds conditions on a property
+type Field struct {
+	key string
+}
+
+// Prop returns a Field building conditions on the property with the given key
+func Prop(key string) Field {
+	return Field{key: key}
+}
+
+func (f Field) condition(op Operator, value any) Condition {
+	return Condition{PropertyKey: f.key, Operator: op, Value: normalize(value)}
+}
+
+// Eq matches objects whose property equals value. Select, multi-select,
+// objects and files properties match when one of their items equals value;
+// tags are compared by ID, key or name.
+func (f Field) Eq(value any) Condition { return f.condition(Equal, value) }
+
+// Ne matches objects whose property does not equal value
+func (f Field) Ne(value any) Condition { return f.condition(NotEqual, value) }
+
+// Gt matches objects whose property is greater than value
+func (f Field) Gt(value any) Condition { return f.condition(Greater, value) }
+
+// Gte matches objects whose property is greater than or equal to value
+func (f Field) Gte(value any) Condition { return f.condition(GreaterOrEqual, value) }
+
+// Lt matches objects whose property is less than value
+func (f Field) Lt(value any) Condition { return f.condition(Less, value) }
+
+// Lte matches objects whose property is less than or equal to value
+func (f Field) Lte(value any) Condition { return f.condition(LessOrEqual, value) }
+
+// Before matches objects whose date property is before t
+func (f Field) Before(t time.Time) Condition { return f.condition(Less, t) }
+
+// After matches objects whose date property is after t
+func (f Field) After(t time.Time) Condition { return f.condition(Greater, t) }
+
+// Like matches objects whose property contains text, ignoring case
+func (f Field) Like(text string) Condition { return f.condition(Like, text) }
+
+// NotLike matches objects whose property does not contain text, ignoring case
+func (f Field) NotLike(text string) Condition { return f.condition(NotLike, text) }
+
+// In matches objects whose property, or one of its items, equals one of values
+func (f Field) In(values ...string) Condition { return f.condition(In, values) }
+
+// NotIn matches objects whose property, and all its items, differ from all values
+func (f Field) NotIn(values ...string) Condition { return f.condition(NotIn, values) }
+
+// AllIn matches objects whose property items include all values
+func (f Field) AllIn(values ...string) Condition { return f.condition(AllIn, values) }
+
+// NotAllIn matches objects whose property items miss one of values
+func (f Field) NotAllIn(values ...string) Condition { return f.condition(NotAllIn, values) }
+
+// ExactIn matches objects whose property items are exactly values, in any order
+func (f Field) ExactIn(values ...string) Condition { return f.condition(ExactIn, values) }
+
+// NotExactIn matches objects whose property items are not exactly values
+func (f Field) NotExactIn(values ...string) Condition { return f.condition(NotExactIn, values) }
+
+// Empty matches objects without a value for the property
+func (f Field) Empty() Condition { return f.condition(Empty, nil) }
+
+// NotEmpty matches objects with a value for the property
+func (f Field) NotEmpty() Condition { return f.condition(NotEmpty, nil) }
+
+// Exists matches objects carrying the property, even without a value
+func (f Field) Exists() Condition { return f.condition(Exists, nil) }
+
+// And implements Expr
+func (c Condition) And(exprs ...Expr) Expr {
+	return And(append([]Expr{c}, exprs...)...)
+}
+
+// Or implements Expr
+func (c Condition) Or(exprs ...Expr) Expr {
+	return Or(append([]Expr{c}, exprs...)...)
+}
+
+// Match implements Expr. Conditions are evaluated as their filter, with the
+// rules of anytype.MatchFilter.
+func (c Condition) Match(obj anytype.Object) bool {
+	return anytype.MatchFilter(c.filter(), obj)
+}
+
+// Filters implements Expr
+func (c Condition) Filters() ([]anytype.ListFilter, error) {
+	return []anytype.ListFilter{c.filter()}, nil
+}
+
+// filter returns the condition in the API filter model
+func (c Condition) filter() anytype.ListFilter {
+	format := c.Format
+	if format == "" {
+		format = valueFormat(c.Value)
+	}
+	return anytype.ListFilter{
+		PropertyKey: c.PropertyKey,
+		Format:      string(format),
+		Condition:   string(c.Operator),
+		Value:       formatValue(c.Value),
+	}
+}
+
+// String implements Expr
+func (c Condition) String() string {
+	switch c.Operator {
+	case Empty, NotEmpty, Exists:
+		return c.PropertyKey + " " + string(c.Operator)
+	}
+	if s, ok := c.Value.(string); ok {
+		return c.PropertyKey + " " + string(c.Operator) + " " + strconv.Quote(s)
+	}
+	return c.PropertyKey + " " + string(c.Operator) + " " + formatValue(c.Value)
+}
+
+// normalize converts the numbers and string lists given to the builders to
+// the value types of Condition
+func normalize(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case fmt.Stringer:
+		if _, ok := value.(time.Time); !ok {
+			return v.String()
+		}
+	}
+	return value
+}
+
+// valueFormat returns the property format implied by a condition value
+func valueFormat(value any) anytype.PropertyFormat {
+	switch value.(type) {
+	case float64:
+		return anytype.PropertyFormatNumber
+	case bool:
+		return anytype.PropertyFormatCheckbox
+	case time.Time:
+		return anytype.PropertyFormatDate
+	case []string:
+		return anytype.PropertyFormatMultiSelect
+	case nil:
+		return ""
+	}
+	return anytype.PropertyFormatText
+}
+
+// formatValue returns a condition value as the string of the API filter model
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []string:
+		return strings.Join(v, ",")
+	}
+	return fmt.Sprint(value)
+}
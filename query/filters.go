@@ -0,0 +1,55 @@
+package query
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// FromFilters returns the conjunction of filters in the API filter model, such
+// as the filters of a list view. Values are parsed according to the format of
+// each filter, falling back to strings when they do not parse. Date only
+// values are kept as strings, so that they still compare with the calendar
+// day of dates.
+func FromFilters(filters []anytype.ListFilter) Expr {
+	exprs := make([]Expr, len(filters))
+	for i, filter := range filters {
+		exprs[i] = Condition{
+			PropertyKey: filter.PropertyKey,
+			Operator:    Operator(filter.Condition),
+			Value:       parseValue(filter),
+			Format:      anytype.PropertyFormat(filter.Format),
+		}
+	}
+	return And(exprs...)
+}
+
+// parseValue returns the value of a filter as a Condition value
+func parseValue(filter anytype.ListFilter) any {
+	switch Operator(filter.Condition) {
+	case Empty, NotEmpty, Exists:
+		return nil
+	case In, NotIn, AllIn, NotAllIn, ExactIn, NotExactIn:
+		return anytype.SplitFilterValues(filter.Value)
+	}
+
+	switch anytype.PropertyFormat(filter.Format) {
+	case anytype.PropertyFormatNumber:
+		if n, err := strconv.ParseFloat(filter.Value, 64); err == nil {
+			return n
+		}
+	case anytype.PropertyFormatCheckbox:
+		if b, err := strconv.ParseBool(filter.Value); err == nil {
+			return b
+		}
+	case anytype.PropertyFormatDate:
+		if _, err := time.Parse(time.DateOnly, filter.Value); err == nil {
+			return filter.Value
+		}
+		if t, ok := anytype.ParseFilterDate(filter.Value); ok {
+			return t
+		}
+	}
+	return filter.Value
+}
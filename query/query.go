@@ -0,0 +1,186 @@
+// Package query expresses conditions on object properties with the filter
+// model used by list views, and evaluates them against objects locally.
+//
+// Conditions are built fluently from property keys:
+//
+//	overdue := query.Prop("status").Ne("done").And(query.Prop("due").Before(time.Now()))
+//	for obj, err := range query.Search(ctx, space, anytype.SearchRequest{Types: []string{"task"}}, overdue) {
+//		...
+//	}
+//
+// The search API only filters by text and type, so Search filters its
+// results locally. Conjunctions of conditions convert to the API filter
+// model with Filters, ready to be sent once search requests accept filters,
+// and the filters of a view convert back with FromFilters.
+package query
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// Expr is a condition on objects
+type Expr interface {
+	// Match reports whether the object satisfies the condition
+	Match(obj anytype.Object) bool
+
+	// And returns a condition satisfied when this one and all exprs are
+	And(exprs ...Expr) Expr
+
+	// Or returns a condition satisfied when this one or any of exprs is
+	Or(exprs ...Expr) Expr
+
+	// Filters returns the condition in the API filter model. Only
+	// conjunctions of property conditions can be expressed, other conditions
+	// return an error wrapping anytype.ErrUnsupported.
+	Filters() ([]anytype.ListFilter, error)
+
+	// String returns a human readable form of the condition
+	String() string
+}
+
+// And returns a condition satisfied when all exprs are. An empty And matches
+// every object.
+func And(exprs ...Expr) Expr {
+	return group{op: "and", exprs: exprs}
+}
+
+// Or returns a condition satisfied when any of exprs is. An empty Or matches
+// no object.
+func Or(exprs ...Expr) Expr {
+	return group{op: "or", exprs: exprs}
+}
+
+// Not returns a condition satisfied when expr is not
+func Not(expr Expr) Expr {
+	return not{expr: expr}
+}
+
+// Filter returns the objects matching expr, in order
+func Filter(objects []anytype.Object, expr Expr) []anytype.Object {
+	var matches []anytype.Object
+	for _, obj := range objects {
+		if expr.Match(obj) {
+			matches = append(matches, obj)
+		}
+	}
+	return matches
+}
+
+// Search returns an iterator over the search results of the space matching
+// expr, fetching pages lazily
+func Search(ctx context.Context, space anytype.SpaceContext, request anytype.SearchRequest, expr Expr) iter.Seq2[anytype.Object, error] {
+	return func(yield func(anytype.Object, error) bool) {
+		for obj, err := range space.SearchAll(ctx, request) {
+			if err != nil {
+				yield(anytype.Object{}, err)
+				return
+			}
+			if expr.Match(obj) && !yield(obj, nil) {
+				return
+			}
+		}
+	}
+}
+
+// group combines conditions with "and" or "or"
+type group struct {
+	op    string
+	exprs []Expr
+}
+
+// Match implements Expr
+func (g group) Match(obj anytype.Object) bool {
+	for _, expr := range g.exprs {
+		if expr.Match(obj) != (g.op == "and") {
+			return g.op != "and"
+		}
+	}
+	return g.op == "and"
+}
+
+// And implements Expr
+func (g group) And(exprs ...Expr) Expr {
+	if g.op == "and" {
+		return And(append(append([]Expr{}, g.exprs...), exprs...)...)
+	}
+	return And(append([]Expr{g}, exprs...)...)
+}
+
+// Or implements Expr
+func (g group) Or(exprs ...Expr) Expr {
+	if g.op == "or" {
+		return Or(append(append([]Expr{}, g.exprs...), exprs...)...)
+	}
+	return Or(append([]Expr{g}, exprs...)...)
+}
+
+// Filters implements Expr
+func (g group) Filters() ([]anytype.ListFilter, error) {
+	if g.op == "or" && len(g.exprs) != 1 {
+		return nil, fmt.Errorf("query: %s: or conditions: %w", g, anytype.ErrUnsupported)
+	}
+
+	var filters []anytype.ListFilter
+	for _, expr := range g.exprs {
+		f, err := expr.Filters()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f...)
+	}
+	return filters, nil
+}
+
+// String implements Expr
+func (g group) String() string {
+	parts := make([]string, len(g.exprs))
+	for i, expr := range g.exprs {
+		parts[i] = expr.String()
+		if _, nested := expr.(group); nested {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+g.op+" ")
+}
+
+// not negates a condition
+type not struct {
+	expr Expr
+}
+
+// Match implements Expr
+func (n not) Match(obj anytype.Object) bool {
+	return !n.expr.Match(obj)
+}
+
+// And implements Expr
+func (n not) And(exprs ...Expr) Expr {
+	return And(append([]Expr{n}, exprs...)...)
+}
+
+// Or implements Expr
+func (n not) Or(exprs ...Expr) Expr {
+	return Or(append([]Expr{n}, exprs...)...)
+}
+
+// Filters implements Expr. Negated property conditions are converted to
+// their opposite condition.
+func (n not) Filters() ([]anytype.ListFilter, error) {
+	if c, ok := n.expr.(Condition); ok {
+		if negated, ok := negations[c.Operator]; ok {
+			c.Operator = negated
+			return c.Filters()
+		}
+	}
+	return nil, fmt.Errorf("query: %s: negated conditions: %w", n, anytype.ErrUnsupported)
+}
+
+// String implements Expr
+func (n not) String() string {
+	return "not (" + n.expr.String() + ")"
+}
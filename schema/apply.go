@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// Apply executes the steps returned by Plan against the space, in order.
+// It stops at the first failing step; running Plan again afterwards
+// returns the remaining steps.
+func Apply(ctx context.Context, space anytype.SpaceContext, steps []Step) error {
+	// IDs of the properties created by earlier steps, used by tag steps
+	created := make(map[string]string)
+
+	for _, step := range steps {
+		if err := applyStep(ctx, space, step, created); err != nil {
+			return fmt.Errorf("schema: failed to %s: %w", step, err)
+		}
+	}
+
+	return nil
+}
+
+// applyStep executes a single step
+func applyStep(ctx context.Context, space anytype.SpaceContext, step Step, created map[string]string) error {
+	switch step.Kind {
+	case KindProperty:
+		switch step.Action {
+		case ActionCreate:
+			resp, err := space.Properties().Create(ctx, *step.CreateProperty)
+			if err != nil {
+				return err
+			}
+			created[step.Key] = resp.Property.ID
+			return nil
+		case ActionUpdate:
+			_, err := space.Property(step.ID).Update(ctx, *step.UpdateProperty)
+			return err
+		case ActionDelete:
+			_, err := space.Property(step.ID).Delete(ctx)
+			return err
+		}
+
+	case KindTag:
+		propertyID := step.PropertyID
+		if propertyID == "" {
+			propertyID = created[step.PropertyKey]
+		}
+		if propertyID == "" {
+			return fmt.Errorf("property %q has not been created", step.PropertyKey)
+		}
+
+		property := space.Property(propertyID)
+		switch step.Action {
+		case ActionCreate:
+			_, err := property.Tags().Create(ctx, *step.CreateTag)
+			return err
+		case ActionUpdate:
+			_, err := property.Tag(step.ID).Update(ctx, *step.UpdateTag)
+			return err
+		case ActionDelete:
+			_, err := property.Tag(step.ID).Delete(ctx)
+			return err
+		}
+
+	case KindType:
+		switch step.Action {
+		case ActionCreate:
+			_, err := space.Types().Create(ctx, *step.CreateType)
+			return err
+		case ActionUpdate:
+			_, err := space.Type(step.ID).Update(ctx, *step.UpdateType)
+			return err
+		case ActionDelete:
+			_, err := space.Type(step.ID).Delete(ctx)
+			return err
+		}
+	}
+
+	return fmt.Errorf("unsupported step")
+}
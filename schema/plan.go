@@ -0,0 +1,347 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// Action is the operation performed by a Step
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Kind is the kind of entity a Step operates on
+type Kind string
+
+const (
+	KindProperty Kind = "property"
+	KindTag      Kind = "tag"
+	KindType     Kind = "type"
+)
+
+// Step is a single change needed to bring a space in line with a Spec
+type Step struct {
+	Action Action
+	Kind   Kind
+
+	// Key is the key of the type or property, or the name of the tag
+	Key string
+
+	// ID is the ID of the existing entity, empty when it is created
+	ID string
+
+	// PropertyKey and PropertyID identify the property a tag belongs to.
+	// PropertyID is empty when the property is created by an earlier step.
+	PropertyKey string
+	PropertyID  string
+
+	// Request payloads, set according to Action and Kind
+	CreateProperty *anytype.CreatePropertyRequest
+	UpdateProperty *anytype.UpdatePropertyRequest
+	CreateTag      *anytype.CreateTagRequest
+	UpdateTag      *anytype.UpdateTagRequest
+	CreateType     *anytype.CreateTypeRequest
+	UpdateType     *anytype.UpdateTypeRequest
+}
+
+// String returns a human readable description of the step
+func (s Step) String() string {
+	if s.Kind == KindTag {
+		return fmt.Sprintf("%s tag %q of property %q", s.Action, s.Key, s.PropertyKey)
+	}
+	return fmt.Sprintf("%s %s %q", s.Action, s.Kind, s.Key)
+}
+
+// Plan compares the spec with the current types, properties and tags of the
+// space and returns the steps needed to converge, in the order they must be
+// applied. An empty plan means the space is already in sync.
+//
+// Existing types and properties not mentioned in the spec are left alone
+// unless they are listed in DeleteTypes or DeleteProperties.
+func Plan(ctx context.Context, space anytype.SpaceContext, spec Spec) ([]Step, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]anytype.Property)
+	for property, err := range space.Properties().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("schema: failed to list properties: %w", err)
+		}
+		properties[property.Key] = property
+	}
+
+	types := make(map[string]anytype.Type)
+	for typ, err := range space.Types().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("schema: failed to list types: %w", err)
+		}
+		types[typ.Key] = typ
+	}
+
+	var steps []Step
+
+	for _, want := range spec.Properties {
+		propertySteps, err := planProperty(ctx, space, want, properties)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, propertySteps...)
+	}
+
+	for _, want := range spec.Types {
+		step, err := planType(want, types, properties, spec.Properties)
+		if err != nil {
+			return nil, err
+		}
+		if step != nil {
+			steps = append(steps, *step)
+		}
+	}
+
+	for _, key := range spec.DeleteTypes {
+		if typ, ok := types[key]; ok {
+			steps = append(steps, Step{Action: ActionDelete, Kind: KindType, Key: key, ID: typ.ID})
+		}
+	}
+
+	for _, key := range spec.DeleteProperties {
+		if property, ok := properties[key]; ok {
+			steps = append(steps, Step{Action: ActionDelete, Kind: KindProperty, Key: key, ID: property.ID})
+		}
+	}
+
+	return steps, nil
+}
+
+// planProperty returns the steps for a property and its tags
+func planProperty(ctx context.Context, space anytype.SpaceContext, want PropertySpec, existing map[string]anytype.Property) ([]Step, error) {
+	have, ok := existing[want.Key]
+	if !ok {
+		steps := []Step{{
+			Action: ActionCreate,
+			Kind:   KindProperty,
+			Key:    want.Key,
+			CreateProperty: &anytype.CreatePropertyRequest{
+				Key:    want.Key,
+				Name:   want.Name,
+				Format: want.Format,
+			},
+		}}
+		for _, tag := range want.Tags {
+			steps = append(steps, createTagStep(want.Key, "", tag))
+		}
+		return steps, nil
+	}
+
+	if have.Format != want.Format {
+		return nil, fmt.Errorf("schema: property %q has format %q, spec requires %q", want.Key, have.Format, want.Format)
+	}
+
+	var steps []Step
+	if have.Name != want.Name {
+		steps = append(steps, Step{
+			Action: ActionUpdate,
+			Kind:   KindProperty,
+			Key:    want.Key,
+			ID:     have.ID,
+			UpdateProperty: &anytype.UpdatePropertyRequest{
+				Name: want.Name,
+			},
+		})
+	}
+
+	if len(want.Tags) == 0 && !want.PruneTags {
+		return steps, nil
+	}
+
+	var current []anytype.Tag
+	tags := make(map[string]anytype.Tag)
+	for tag, err := range space.Property(have.ID).Tags().All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("schema: failed to list tags of property %q: %w", want.Key, err)
+		}
+		current = append(current, tag)
+		tags[tag.Name] = tag
+	}
+
+	wanted := make(map[string]bool)
+	for _, tag := range want.Tags {
+		wanted[tag.Name] = true
+
+		existing, ok := tags[tag.Name]
+		if !ok {
+			steps = append(steps, createTagStep(want.Key, have.ID, tag))
+			continue
+		}
+
+		if tag.Color != "" && existing.Color != tag.Color {
+			steps = append(steps, Step{
+				Action:      ActionUpdate,
+				Kind:        KindTag,
+				Key:         tag.Name,
+				ID:          existing.ID,
+				PropertyKey: want.Key,
+				PropertyID:  have.ID,
+				UpdateTag: &anytype.UpdateTagRequest{
+					Name:  tag.Name,
+					Color: tag.Color,
+				},
+			})
+		}
+	}
+
+	if want.PruneTags {
+		for _, tag := range current {
+			if !wanted[tag.Name] {
+				steps = append(steps, Step{
+					Action:      ActionDelete,
+					Kind:        KindTag,
+					Key:         tag.Name,
+					ID:          tag.ID,
+					PropertyKey: want.Key,
+					PropertyID:  have.ID,
+				})
+			}
+		}
+	}
+
+	return steps, nil
+}
+
+// createTagStep returns the step creating a tag, defaulting its color to grey
+func createTagStep(propertyKey, propertyID string, tag TagSpec) Step {
+	color := tag.Color
+	if color == "" {
+		color = anytype.ColorGrey
+	}
+
+	return Step{
+		Action:      ActionCreate,
+		Kind:        KindTag,
+		Key:         tag.Name,
+		PropertyKey: propertyKey,
+		PropertyID:  propertyID,
+		CreateTag: &anytype.CreateTagRequest{
+			Name:  tag.Name,
+			Color: color,
+		},
+	}
+}
+
+// planType returns the step for a type, or nil if it is already in sync
+func planType(want TypeSpec, existing map[string]anytype.Type, properties map[string]anytype.Property, declared []PropertySpec) (*Step, error) {
+	definitions, err := propertyDefinitions(want, properties, declared)
+	if err != nil {
+		return nil, err
+	}
+
+	have, ok := existing[want.Key]
+	if !ok {
+		layout := want.Layout
+		if layout == "" {
+			layout = "basic"
+		}
+		pluralName := want.PluralName
+		if pluralName == "" {
+			pluralName = want.Name
+		}
+
+		return &Step{
+			Action: ActionCreate,
+			Kind:   KindType,
+			Key:    want.Key,
+			CreateType: &anytype.CreateTypeRequest{
+				Key:        want.Key,
+				Name:       want.Name,
+				PluralName: pluralName,
+				Layout:     layout,
+				Icon:       want.Icon,
+				Properties: definitions,
+			},
+		}, nil
+	}
+
+	changed := have.Name != want.Name ||
+		(want.PluralName != "" && have.PluralName != want.PluralName) ||
+		(want.Layout != "" && have.Layout != want.Layout) ||
+		(want.Icon != nil && (have.Icon == nil || *have.Icon != *want.Icon))
+
+	// Only missing links are considered a change, so that properties the
+	// API links to every type do not show up as drift
+	linked := make(map[string]bool)
+	for _, definition := range have.PropertyDefinitions {
+		linked[definition.Key] = true
+	}
+	for _, key := range want.Properties {
+		if !linked[key] {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil, nil
+	}
+
+	// The update sets the linked properties, so the current links are kept
+	// and the missing ones added
+	links := append([]anytype.PropertyDefinition{}, have.PropertyDefinitions...)
+	for _, definition := range definitions {
+		if !linked[definition.Key] {
+			links = append(links, definition)
+		}
+	}
+
+	return &Step{
+		Action: ActionUpdate,
+		Kind:   KindType,
+		Key:    want.Key,
+		ID:     have.ID,
+		UpdateType: &anytype.UpdateTypeRequest{
+			Name:       want.Name,
+			PluralName: want.PluralName,
+			Layout:     want.Layout,
+			Icon:       want.Icon,
+			Properties: links,
+		},
+	}, nil
+}
+
+// propertyDefinitions resolves the property keys of a type spec, looking in
+// the spec first and in the existing properties of the space second
+func propertyDefinitions(want TypeSpec, properties map[string]anytype.Property, declared []PropertySpec) ([]anytype.PropertyDefinition, error) {
+	var definitions []anytype.PropertyDefinition
+
+outer:
+	for _, key := range want.Properties {
+		for _, property := range declared {
+			if property.Key == key {
+				definitions = append(definitions, anytype.PropertyDefinition{
+					Key:    property.Key,
+					Name:   property.Name,
+					Format: property.Format,
+				})
+				continue outer
+			}
+		}
+
+		property, ok := properties[key]
+		if !ok {
+			return nil, fmt.Errorf("schema: type %q links unknown property %q", want.Key, key)
+		}
+
+		definitions = append(definitions, anytype.PropertyDefinition{
+			Key:    property.Key,
+			Name:   property.Name,
+			Format: property.Format,
+		})
+	}
+
+	return definitions, nil
+}
@@ -0,0 +1,170 @@
+// Package schema keeps the types, properties and tags of a space in sync with
+// a declarative specification.
+//
+// A Spec is usually loaded with Load from a JSON file kept under version
+// control. Plan compares it against the current state of a space and returns
+// the steps needed to converge, and Apply executes them:
+//
+//	steps, err := schema.Plan(ctx, client.Space(spaceID), spec)
+//	if err != nil {
+//		return err
+//	}
+//	for _, step := range steps {
+//		fmt.Println(step)
+//	}
+//	err = schema.Apply(ctx, client.Space(spaceID), steps)
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// keyRe matches the snake_case keys the API keeps as given. Other keys are
+// converted, so a spec using them would never match the space.
+var keyRe = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+
+// Spec describes the desired shape of a space
+type Spec struct {
+	// Properties lists the space-level properties that must exist
+	Properties []PropertySpec `json:"properties,omitempty"`
+
+	// Types lists the object types that must exist
+	Types []TypeSpec `json:"types,omitempty"`
+
+	// DeleteProperties lists the keys of properties that must not exist
+	DeleteProperties []string `json:"delete_properties,omitempty"`
+
+	// DeleteTypes lists the keys of types that must not exist
+	DeleteTypes []string `json:"delete_types,omitempty"`
+}
+
+// PropertySpec describes a space-level property
+type PropertySpec struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	Format string `json:"format"`
+
+	// Tags lists the options of a select or multi_select property, other
+	// formats have no tags
+	Tags []TagSpec `json:"tags,omitempty"`
+
+	// PruneTags deletes existing tags that are not listed in Tags
+	PruneTags bool `json:"prune_tags,omitempty"`
+}
+
+// TagSpec describes a tag of a select or multi_select property.
+// Tags are matched by name.
+type TagSpec struct {
+	Name  string        `json:"name"`
+	Color anytype.Color `json:"color,omitempty"`
+}
+
+// TypeSpec describes an object type
+type TypeSpec struct {
+	Key        string        `json:"key"`
+	Name       string        `json:"name"`
+	PluralName string        `json:"plural_name,omitempty"`
+	Layout     string        `json:"layout,omitempty"`
+	Icon       *anytype.Icon `json:"icon,omitempty"`
+
+	// Properties lists the keys of the properties linked to the type. Keys
+	// may refer to properties in the spec or to properties already in the space.
+	Properties []string `json:"properties,omitempty"`
+}
+
+// Load decodes a JSON encoded Spec from r and validates it
+func Load(r io.Reader) (*Spec, error) {
+	var spec Spec
+	if err := json.NewDecoder(r).Decode(&spec); err != nil {
+		return nil, fmt.Errorf("schema: failed to decode spec: %w", err)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// Validate checks the spec for missing fields, keys that are not snake_case,
+// unknown formats, duplicate keys, unknown colors and tags on formats other
+// than select and multi_select
+func (s *Spec) Validate() error {
+	properties := make(map[string]bool)
+	for _, property := range s.Properties {
+		if property.Key == "" {
+			return fmt.Errorf("schema: property %q has no key", property.Name)
+		}
+		if !keyRe.MatchString(property.Key) {
+			return fmt.Errorf("schema: property key %q is not snake_case", property.Key)
+		}
+		if property.Name == "" || property.Format == "" {
+			return fmt.Errorf("schema: property %q requires a name and a format", property.Key)
+		}
+		if !anytype.PropertyFormat(property.Format).IsValid() {
+			return fmt.Errorf("schema: property %q has invalid format %q", property.Key, property.Format)
+		}
+		if properties[property.Key] {
+			return fmt.Errorf("schema: duplicate property %q", property.Key)
+		}
+		properties[property.Key] = true
+
+		switch anytype.PropertyFormat(property.Format) {
+		case anytype.PropertyFormatSelect, anytype.PropertyFormatMultiSelect:
+		default:
+			if len(property.Tags) > 0 || property.PruneTags {
+				return fmt.Errorf("schema: property %q has format %q, only select and multi_select properties have tags", property.Key, property.Format)
+			}
+		}
+
+		tags := make(map[string]bool)
+		for _, tag := range property.Tags {
+			if tag.Name == "" {
+				return fmt.Errorf("schema: property %q has a tag without a name", property.Key)
+			}
+			if tag.Color != "" && !tag.Color.IsValid() {
+				return fmt.Errorf("schema: tag %q of property %q has invalid color %q", tag.Name, property.Key, tag.Color)
+			}
+			if tags[tag.Name] {
+				return fmt.Errorf("schema: duplicate tag %q in property %q", tag.Name, property.Key)
+			}
+			tags[tag.Name] = true
+		}
+	}
+
+	types := make(map[string]bool)
+	for _, typ := range s.Types {
+		if typ.Key == "" {
+			return fmt.Errorf("schema: type %q has no key", typ.Name)
+		}
+		if !keyRe.MatchString(typ.Key) {
+			return fmt.Errorf("schema: type key %q is not snake_case", typ.Key)
+		}
+		if typ.Name == "" {
+			return fmt.Errorf("schema: type %q requires a name", typ.Key)
+		}
+		if types[typ.Key] {
+			return fmt.Errorf("schema: duplicate type %q", typ.Key)
+		}
+		types[typ.Key] = true
+	}
+
+	for _, key := range s.DeleteProperties {
+		if properties[key] {
+			return fmt.Errorf("schema: property %q is both declared and deleted", key)
+		}
+	}
+
+	for _, key := range s.DeleteTypes {
+		if types[key] {
+			return fmt.Errorf("schema: type %q is both declared and deleted", key)
+		}
+	}
+
+	return nil
+}
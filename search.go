@@ -2,17 +2,24 @@ package anytype
 
 import (
 	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // SearchClient provides global search operations across all spaces
 type SearchClient interface {
 	// Search searches for objects across all spaces
 	Search(ctx context.Context, request SearchRequest) (*SearchResponse, error)
+
+	// SearchAll returns an iterator over all search results across all spaces, fetching pages lazily
+	SearchAll(ctx context.Context, request SearchRequest, opts ...options.ListOption) iter.Seq2[Object, error]
 }
 
 // SearchResponse represents the response from a search operation
 type SearchResponse struct {
-	Data []Object `json:"data"`
+	Data       []Object                   `json:"data"`
+	Pagination options.PaginationMetadata `json:"pagination"`
 }
 
 // SearchRequest represents a search query
@@ -2,6 +2,7 @@ package anytype
 
 import (
 	"context"
+	"iter"
 
 	"github.com/rubiojr/anytype-go/options"
 )
@@ -13,6 +14,9 @@ type SpaceClient interface {
 
 	// Create creates a new space
 	Create(ctx context.Context, request CreateSpaceRequest) (*CreateSpaceResponse, error)
+
+	// All returns an iterator over all spaces accessible to the user, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Space, error]
 }
 
 // SpaceContext provides operations within a specific space
@@ -20,6 +24,9 @@ type SpaceContext interface {
 	// Get retrieves information about this space
 	Get(ctx context.Context) (*SpaceResponse, error)
 
+	// Update updates the name, description or icon of this space
+	Update(ctx context.Context, request UpdateSpaceRequest) (*SpaceResponse, error)
+
 	// Objects returns an ObjectClient for this space
 	Objects() ObjectClient
 
@@ -35,9 +42,15 @@ type SpaceContext interface {
 	// Properties returns a SpacePropertyClient for this space
 	Properties() SpacePropertyClient
 
+	// Property returns a PropertyContext for a specific property in this space
+	Property(propertyID string) PropertyContext
+
 	// Search searches for objects within this space
 	Search(ctx context.Context, request SearchRequest, opts ...options.ListOption) (*SearchResponse, error)
 
+	// SearchAll returns an iterator over all search results within this space, fetching pages lazily
+	SearchAll(ctx context.Context, request SearchRequest, opts ...options.ListOption) iter.Seq2[Object, error]
+
 	// Lists returns a ListClient for this space
 	Lists() ListClient
 
@@ -58,6 +71,30 @@ type SpacePropertyClient interface {
 
 	// List returns all properties in the space
 	List(ctx context.Context) ([]Property, error)
+
+	// All returns an iterator over all properties in the space, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Property, error]
+
+	// GetByKey looks up a property by its key
+	GetByKey(ctx context.Context, key string) (*Property, error)
+}
+
+// PropertyContext provides operations on a specific space-level property
+type PropertyContext interface {
+	// Get retrieves details of this property
+	Get(ctx context.Context) (*PropertyResponse, error)
+
+	// Update updates the name or key of this property
+	Update(ctx context.Context, request UpdatePropertyRequest) (*PropertyResponse, error)
+
+	// Delete deletes this property
+	Delete(ctx context.Context) (*PropertyResponse, error)
+
+	// Tags returns a TagClient for the tags of this property
+	Tags() TagClient
+
+	// Tag returns a TagContext for a specific tag of this property
+	Tag(tagID string) TagContext
 }
 
 // Space represents an Anytype workspace/space
@@ -75,7 +112,8 @@ type Space struct {
 
 // SpaceListResponse represents the response from List spaces
 type SpaceListResponse struct {
-	Data []Space `json:"data"`
+	Data       []Space                    `json:"data"`
+	Pagination options.PaginationMetadata `json:"pagination"`
 }
 
 // CreateSpaceResponse represents the response from Create space
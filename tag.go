@@ -0,0 +1,81 @@
+package anytype
+
+import (
+	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go/options"
+)
+
+// TagClient provides operations on the tags (select options) of a property
+type TagClient interface {
+	// List returns all tags of the property
+	List(ctx context.Context) ([]Tag, error)
+
+	// All returns an iterator over all tags of the property, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Tag, error]
+
+	// Create creates a new tag for the property
+	Create(ctx context.Context, request CreateTagRequest) (*TagResponse, error)
+}
+
+// TagContext provides operations on a specific tag
+type TagContext interface {
+	// Get retrieves details of this tag
+	Get(ctx context.Context) (*TagResponse, error)
+
+	// Update updates the name or color of this tag
+	Update(ctx context.Context, request UpdateTagRequest) (*TagResponse, error)
+
+	// Delete deletes this tag
+	Delete(ctx context.Context) (*TagResponse, error)
+}
+
+// Color represents a color of a tag or icon
+type Color string
+
+const (
+	ColorGrey   Color = "grey"
+	ColorYellow Color = "yellow"
+	ColorOrange Color = "orange"
+	ColorRed    Color = "red"
+	ColorPink   Color = "pink"
+	ColorPurple Color = "purple"
+	ColorBlue   Color = "blue"
+	ColorIce    Color = "ice"
+	ColorTeal   Color = "teal"
+	ColorLime   Color = "lime"
+)
+
+// Colors lists all colors supported by the API
+var Colors = []Color{
+	ColorGrey, ColorYellow, ColorOrange, ColorRed, ColorPink,
+	ColorPurple, ColorBlue, ColorIce, ColorTeal, ColorLime,
+}
+
+// IsValid reports whether the color is one of the colors supported by the API
+func (c Color) IsValid() bool {
+	for _, color := range Colors {
+		if c == color {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateTagRequest contains parameters for creating a new tag
+type CreateTagRequest struct {
+	Name  string `json:"name"`
+	Color Color  `json:"color"`
+}
+
+// UpdateTagRequest contains parameters for updating a tag
+type UpdateTagRequest struct {
+	Name  string `json:"name,omitempty"`
+	Color Color  `json:"color,omitempty"`
+}
+
+// TagResponse represents the response from creating, getting or updating a tag
+type TagResponse struct {
+	Tag Tag `json:"tag"`
+}
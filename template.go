@@ -2,6 +2,9 @@ package anytype
 
 import (
 	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // TemplateClient provides operations on templates for a specific type
@@ -9,6 +12,9 @@ type TemplateClient interface {
 	// List retrieves all templates for a type
 	List(ctx context.Context) ([]Template, error)
 
+	// All returns an iterator over all templates for a type, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Template, error]
+
 	// Get retrieves a specific template by ID
 	Get(ctx context.Context, templateID string) (*Template, error)
 }
@@ -78,6 +78,23 @@ func TestSpaces(t *testing.T) {
 	if spaceDetails.Space.Name == "" {
 		t.Error("Space name should not be empty")
 	}
+
+	// Rename the space, leaving the description untouched
+	newName := "Renamed Space"
+	updated, err := tc.Client.Space(spaceID).Update(tc.Ctx, anytype.UpdateSpaceRequest{
+		Name: &newName,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update space: %v", err)
+	}
+
+	if updated.Space.Name != newName {
+		t.Errorf("Space name mismatch: got %s, want %s", updated.Space.Name, newName)
+	}
+
+	if updated.Space.Description != spaceDetails.Space.Description {
+		t.Errorf("Space description changed unexpectedly: got %s, want %s", updated.Space.Description, spaceDetails.Space.Description)
+	}
 }
 
 // TestObjectTypes tests operations with object types
@@ -113,3 +130,42 @@ func TestObjectTypes(t *testing.T) {
 		t.Logf("Found page type with key: %s", pageTypeKey)
 	}
 }
+
+// TestTypeLifecycle tests updating and deleting an object type
+func TestTypeLifecycle(t *testing.T) {
+	tc := setupTestClient(t)
+	defer cleanupTestClient(tc)
+
+	spaceID := findOrCreateTestSpace(t, tc)
+	typeID := "mock-type-id"
+
+	// Rename the type
+	updateReq := anytype.UpdateTypeRequest{
+		Name:       "Book",
+		PluralName: "Books",
+		Layout:     "basic",
+	}
+
+	updated, err := tc.Client.Space(spaceID).Type(typeID).Update(tc.Ctx, updateReq)
+	if err != nil {
+		t.Fatalf("Failed to update type: %v", err)
+	}
+
+	if updated.Type.Name != updateReq.Name {
+		t.Errorf("Type name mismatch: got %s, want %s", updated.Type.Name, updateReq.Name)
+	}
+
+	if updated.Type.PluralName != updateReq.PluralName {
+		t.Errorf("Type plural name mismatch: got %s, want %s", updated.Type.PluralName, updateReq.PluralName)
+	}
+
+	// Delete the type
+	deleted, err := tc.Client.Space(spaceID).Type(typeID).Delete(tc.Ctx)
+	if err != nil {
+		t.Fatalf("Failed to delete type: %v", err)
+	}
+
+	if !deleted.Type.IsArchived {
+		t.Error("Expected deleted type to be archived")
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+const blocksMarkdown = "# Plan\n\nFirst line\nsecond line\n\n- [x] Done item\n  - Nested item\n- [ ] Open item\n\n> Quoted\n\n```go\nfmt.Println()\n```\n"
+
+// TestBlocks tests reading the blocks of an object from its markdown body
+func TestBlocks(t *testing.T) {
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/mock-space-id/objects/object-id" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"object": map[string]any{"id": "object-id", "markdown": blocksMarkdown},
+		})
+	}))
+	defer cleanupTestClient(tc)
+
+	blocks := tc.Client.Space(tc.SpaceID).Object("object-id").Blocks()
+
+	list, err := blocks.List(tc.Ctx)
+	if err != nil {
+		t.Fatalf("Failed to list blocks: %v", err)
+	}
+
+	want := []struct {
+		style   string
+		content string
+	}{
+		{anytype.TextStyleHeader1, "Plan"},
+		{anytype.TextStyleParagraph, "First line\nsecond line"},
+		{anytype.TextStyleCheckbox, "Done item"},
+		{anytype.TextStyleMarked, "Nested item"},
+		{anytype.TextStyleCheckbox, "Open item"},
+		{anytype.TextStyleQuote, "Quoted"},
+		{anytype.TextStyleCode, "fmt.Println()"},
+	}
+
+	if len(list) != len(want) {
+		t.Fatalf("Block count mismatch: got %d, want %d", len(list), len(want))
+	}
+
+	for i, block := range list {
+		if block.Text.Style != want[i].style || block.Text.Content != want[i].content {
+			t.Errorf("Block %d mismatch: got %s %q, want %s %q", i, block.Text.Style, block.Text.Content, want[i].style, want[i].content)
+		}
+	}
+
+	if !list[2].Text.Checked || list[4].Text.Checked {
+		t.Error("Checkbox state mismatch")
+	}
+
+	if len(list[2].ChildrenIDs) != 1 || list[2].ChildrenIDs[0] != list[3].ID {
+		t.Errorf("Expected nested item to be a child of the first item, got %v", list[2].ChildrenIDs)
+	}
+
+	// Get a block by ID
+	block, err := blocks.Get(tc.Ctx, list[1].ID)
+	if err != nil {
+		t.Fatalf("Failed to get block: %v", err)
+	}
+
+	if block.Text.Content != list[1].Text.Content {
+		t.Errorf("Block content mismatch: got %q, want %q", block.Text.Content, list[1].Text.Content)
+	}
+
+	if _, err := blocks.Get(tc.Ctx, "missing-block"); !errors.Is(err, anytype.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing block, got %v", err)
+	}
+
+	// Editing blocks is not supported by the API
+	if _, err := blocks.Create(tc.Ctx, anytype.CreateBlockRequest{}); !errors.Is(err, anytype.ErrUnsupported) {
+		t.Errorf("Expected ErrUnsupported from Create, got %v", err)
+	}
+
+	if err := blocks.Update(tc.Ctx, list[0].ID, anytype.UpdateBlockRequest{}); !errors.Is(err, anytype.ErrUnsupported) {
+		t.Errorf("Expected ErrUnsupported from Update, got %v", err)
+	}
+
+	if err := blocks.Delete(tc.Ctx, list[0].ID); !errors.Is(err, anytype.ErrUnsupported) {
+		t.Errorf("Expected ErrUnsupported from Delete, got %v", err)
+	}
+}
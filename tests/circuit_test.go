@@ -0,0 +1,227 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
+// TestCircuitBreaker tests opening, probing and closing the circuit
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		down          atomic.Bool
+		sent          int32
+		disconnects   int32
+		reconnections int32
+	)
+	next := doerFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		if down.Load() {
+			return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+
+	m := middleware.NewDisconnectMiddleware(next, middleware.DisconnectConfig{
+		FailureThreshold:     2,
+		ReconnectDelay:       20 * time.Millisecond,
+		MaxReconnectAttempts: 1,
+		OnDisconnect:         func() { atomic.AddInt32(&disconnects, 1) },
+		OnReconnect:          func() { atomic.AddInt32(&reconnections, 1) },
+	})
+
+	do := func() error {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/v1/spaces", nil)
+		_, err := m.Do(req)
+		return err
+	}
+	expectState := func(want middleware.CircuitState) {
+		t.Helper()
+		if got := m.State(); got != want {
+			t.Fatalf("Expected the circuit to be %s, got %s", want, got)
+		}
+	}
+
+	down.Store(true)
+	do()
+	expectState(middleware.CircuitClosed)
+	do()
+	expectState(middleware.CircuitOpen)
+
+	// Requests fail fast while open
+	err := do()
+	var openErr *middleware.CircuitOpenError
+	if !errors.Is(err, middleware.ErrCircuitOpen) || !errors.As(err, &openErr) {
+		t.Fatalf("Expected a CircuitOpenError, got %v", err)
+	}
+	if got := atomic.LoadInt32(&sent); got != 2 {
+		t.Errorf("Expected 2 requests to be sent, got %d", got)
+	}
+
+	// The failed probe reopens the circuit for twice the delay
+	time.Sleep(30 * time.Millisecond)
+	if err := do(); errors.Is(err, middleware.ErrCircuitOpen) {
+		t.Fatal("Expected a probe request to be sent")
+	}
+	expectState(middleware.CircuitOpen)
+	time.Sleep(20 * time.Millisecond)
+	if err := do(); !errors.Is(err, middleware.ErrCircuitOpen) {
+		t.Fatalf("Expected the reconnect delay to double, got %v", err)
+	}
+
+	// The successful probe closes the circuit
+	down.Store(false)
+	time.Sleep(30 * time.Millisecond)
+	if err := do(); err != nil {
+		t.Fatalf("Expected the probe to succeed, got %v", err)
+	}
+	expectState(middleware.CircuitClosed)
+
+	if disconnects != 1 || reconnections != 1 {
+		t.Errorf("Expected 1 disconnection and 1 reconnection, got %d and %d", disconnects, reconnections)
+	}
+}
+
+// TestCircuitBreakerMaxReconnectDelay tests that the reconnect delay stops
+// doubling after MaxReconnectShift failed probes
+func TestCircuitBreakerMaxReconnectDelay(t *testing.T) {
+	var sent int32
+	next := doerFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	})
+
+	m := middleware.NewDisconnectMiddleware(next, middleware.DisconnectConfig{
+		FailureThreshold:     1,
+		ReconnectDelay:       time.Nanosecond,
+		MaxReconnectAttempts: 64,
+	})
+	maxDelay := time.Nanosecond << middleware.MaxReconnectShift
+
+	for atomic.LoadInt32(&sent) < 40 {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/v1/spaces", nil)
+		_, err := m.Do(req)
+
+		var openErr *middleware.CircuitOpenError
+		if !errors.As(err, &openErr) {
+			continue
+		}
+		wait := time.Until(openErr.RetryAt)
+		if wait > maxDelay {
+			t.Fatalf("Expected the reconnect delay to be capped at %v after %d probes, got %v", maxDelay, sent, wait)
+		}
+		time.Sleep(wait)
+	}
+}
+
+// TestCircuitBreakerSingleProbe tests that a half-open circuit lets a single request through
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	release := make(chan struct{})
+	var calls int32
+	next := doerFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+		}
+		<-release
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+
+	m := middleware.NewDisconnectMiddleware(next, middleware.DisconnectConfig{FailureThreshold: 1, ReconnectDelay: time.Millisecond})
+	newRequest := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/v1/spaces", nil)
+		return req
+	}
+
+	m.Do(newRequest())
+	time.Sleep(5 * time.Millisecond)
+
+	done := make(chan error)
+	go func() {
+		_, err := m.Do(newRequest())
+		done <- err
+	}()
+	for m.State() != middleware.CircuitHalfOpen {
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := m.Do(newRequest()); !errors.Is(err, middleware.ErrCircuitOpen) {
+		t.Errorf("Expected requests to be rejected during the probe, got %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Expected the probe to succeed, got %v", err)
+	}
+	if m.State() != middleware.CircuitClosed {
+		t.Errorf("Expected the circuit to close, got %s", m.State())
+	}
+}
+
+// TestCircuitBreakerClient tests that clients fail fast without retrying when the circuit is open, and report it
+func TestCircuitBreakerClient(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	baseURL := "http://" + listener.Addr().String()
+	listener.Close()
+
+	retryConfig := middleware.DefaultRetryConfig()
+	retryConfig.RetryDelay = time.Millisecond
+	retryConfig.MaxRetryDelay = time.Millisecond
+
+	client := anytype.NewClient(
+		anytype.WithBaseURL(baseURL),
+		anytype.WithRetryConfig(retryConfig),
+		anytype.WithDisconnect(middleware.DisconnectConfig{FailureThreshold: 2, ReconnectDelay: time.Minute}),
+	)
+	reporter, ok := client.(anytype.CircuitReporter)
+	if !ok {
+		t.Fatal("Expected the client to report its circuit state")
+	}
+	if reporter.CircuitState() != middleware.CircuitClosed {
+		t.Fatalf("Expected the circuit to start closed, got %s", reporter.CircuitState())
+	}
+
+	_, err = client.Space("space-id").Get(context.Background())
+	if !errors.Is(err, middleware.ErrCircuitOpen) {
+		t.Errorf("Expected retries to stop once the circuit opens, got %v", err)
+	}
+	if reporter.CircuitState() != middleware.CircuitOpen {
+		t.Errorf("Expected the client to report the open circuit, got %s", reporter.CircuitState())
+	}
+}
+
+// TestCircuitBreakerContextErrors tests that requests cancelled or timed out by the caller do not open the circuit
+func TestCircuitBreakerContextErrors(t *testing.T) {
+	next := doerFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: req.Context().Err()}
+	})
+	m := middleware.NewDisconnectMiddleware(next, middleware.DisconnectConfig{FailureThreshold: 1, ReconnectDelay: time.Minute})
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelExpired()
+
+	for _, ctx := range []context.Context{cancelled, expired} {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/v1/spaces", nil)
+		if _, err := m.Do(req); err == nil {
+			t.Fatal("Expected the request to fail")
+		}
+		if m.State() != middleware.CircuitClosed || !m.IsConnected() {
+			t.Fatalf("Expected context errors to leave the circuit closed, got %s", m.State())
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
+// recordingDoer counts the requests passing through it
+type recordingDoer struct {
+	next  middleware.HTTPDoer
+	calls *int32
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(d.calls, 1)
+	return d.next.Do(req)
+}
+
+// TestClientMiddleware tests that custom middleware is applied to requests
+func TestClientMiddleware(t *testing.T) {
+	var calls int32
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"space":{"id":"mock-space-id","name":"Mock Space"}}`))
+	}), anytype.WithMiddleware(func(next middleware.HTTPDoer) middleware.HTTPDoer {
+		return &recordingDoer{next: next, calls: &calls}
+	}))
+	defer cleanupTestClient(tc)
+
+	for i := 0; i < 3; i++ {
+		if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err != nil {
+			t.Fatalf("Failed to get space: %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("Middleware call count mismatch: got %d, want 3", got)
+	}
+}
+
+// TestClientRetryOptions tests retry configuration through client options
+func TestClientRetryOptions(t *testing.T) {
+	newHandler := func(requests *int32) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(requests, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+	}
+
+	t.Run("custom retry config", func(t *testing.T) {
+		var requests int32
+		retryConfig := middleware.DefaultRetryConfig()
+		retryConfig.MaxRetries = 2
+		retryConfig.RetryDelay = time.Millisecond
+
+		tc := setupHTTPTestClient(t, newHandler(&requests), anytype.WithRetryConfig(retryConfig))
+		defer cleanupTestClient(tc)
+
+		if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err == nil {
+			t.Fatal("Expected an error")
+		}
+
+		if got := atomic.LoadInt32(&requests); got != 3 {
+			t.Errorf("Request count mismatch: got %d, want 3", got)
+		}
+	})
+
+	t.Run("without retry", func(t *testing.T) {
+		var requests int32
+		tc := setupHTTPTestClient(t, newHandler(&requests), anytype.WithoutRetry())
+		defer cleanupTestClient(tc)
+
+		if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err == nil {
+			t.Fatal("Expected an error")
+		}
+
+		if got := atomic.LoadInt32(&requests); got != 1 {
+			t.Errorf("Request count mismatch: got %d, want 1", got)
+		}
+	})
+}
+
+// TestClientHTTPClient tests that a custom HTTP client is used
+func TestClientHTTPClient(t *testing.T) {
+	var used int32
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&used, 1)
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"space":{"id":"mock-space-id","name":"Mock Space"}}`))
+	}), anytype.WithHTTPClient(httpClient))
+	defer cleanupTestClient(tc)
+
+	if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err != nil {
+		t.Fatalf("Failed to get space: %v", err)
+	}
+
+	if atomic.LoadInt32(&used) == 0 {
+		t.Error("Expected the custom HTTP client to be used")
+	}
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// codecTask is a struct mapped to a task object
+type codecTask struct {
+	ID        string        `anytype:"id"`
+	Title     string        `anytype:"name"`
+	Notes     string        `anytype:"body"`
+	Icon      *anytype.Icon `anytype:"icon"`
+	Due       time.Time     `anytype:"due_date,date"`
+	Status    string        `anytype:"status,select"`
+	Labels    []anytype.Tag `anytype:"labels,multi_select"`
+	Estimate  int           `anytype:"estimate"`
+	Done      bool          `anytype:"done"`
+	Link      string        `anytype:"source,url,omitempty"`
+	Assignees []string      `anytype:"assignees,objects"`
+	Ignored   string
+}
+
+// TestCodecMarshal tests building a create request from a tagged struct
+func TestCodecMarshal(t *testing.T) {
+	due := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	task := codecTask{
+		Title:  "Write report",
+		Notes:  "# Report",
+		Icon:   &anytype.Icon{Format: anytype.IconFormatEmoji, Emoji: "📝"},
+		Due:    due,
+		Status: "open-tag-id",
+		Labels: []anytype.Tag{{ID: "a"}, {ID: "b"}},
+	}
+
+	request, err := anytype.Marshal(task)
+	if err != nil {
+		t.Fatalf("Failed to marshal task: %v", err)
+	}
+
+	if request.Name != task.Title || request.Body != task.Notes || request.Icon.Emoji != "📝" {
+		t.Errorf("Unexpected object fields: %+v", request)
+	}
+
+	var keys []string
+	for _, property := range request.Properties {
+		keys = append(keys, property.Key)
+	}
+
+	// Zero values are kept unless omitempty is set
+	if got := strings.Join(keys, ","); got != "due_date,status,labels,estimate,done,assignees" {
+		t.Errorf("Property keys mismatch: got %s", got)
+	}
+
+	if v, ok := request.Properties[3].Value.(anytype.NumberValue); !ok || v.Number != 0 {
+		t.Errorf("Unexpected estimate value: %#v", request.Properties[3].Value)
+	}
+}
+
+// TestCodecUnmarshal tests filling a tagged struct from an object
+func TestCodecUnmarshal(t *testing.T) {
+	due := time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC)
+	object := &anytype.Object{
+		ID:       "task-id",
+		Name:     "Write report",
+		Markdown: "# Report",
+		Properties: []anytype.PropertyWithValue{
+			{Key: "due_date", Format: anytype.PropertyFormatDate, Value: anytype.DateValue{Date: due}},
+			{Key: "status", Format: anytype.PropertyFormatSelect, Value: anytype.SelectValue{Tag: &anytype.Tag{ID: "open-tag-id", Name: "Open"}}},
+			{Key: "labels", Format: anytype.PropertyFormatMultiSelect, Value: anytype.MultiSelectValue{Tags: []anytype.Tag{{ID: "a", Name: "A"}}}},
+			{Key: "estimate", Format: anytype.PropertyFormatNumber, Value: anytype.NumberValue{Number: 3}},
+			{Key: "done", Format: anytype.PropertyFormatCheckbox, Value: anytype.CheckboxValue{Checkbox: true}},
+			{Key: "assignees", Format: anytype.PropertyFormatObjects, Value: anytype.ObjectsValue{Objects: []string{"member-id"}}},
+		},
+	}
+
+	var task codecTask
+	if err := anytype.Unmarshal(object, &task); err != nil {
+		t.Fatalf("Failed to unmarshal task: %v", err)
+	}
+
+	if task.ID != "task-id" || task.Title != "Write report" || task.Notes != "# Report" {
+		t.Errorf("Unexpected object fields: %+v", task)
+	}
+
+	if !task.Due.Equal(due) || task.Status != "open-tag-id" || task.Estimate != 3 || !task.Done {
+		t.Errorf("Unexpected property fields: %+v", task)
+	}
+
+	if len(task.Labels) != 1 || task.Labels[0].Name != "A" {
+		t.Errorf("Unexpected labels: %+v", task.Labels)
+	}
+
+	if len(task.Assignees) != 1 || task.Assignees[0] != "member-id" {
+		t.Errorf("Unexpected assignees: %v", task.Assignees)
+	}
+}
+
+// TestCodecErrors tests that invalid tags and mismatched formats are reported
+func TestCodecErrors(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
+		type bad struct {
+			Value string `anytype:"value,hologram"`
+		}
+		if _, err := anytype.Marshal(bad{}); err == nil || !strings.Contains(err.Error(), "unknown property format") {
+			t.Errorf("Expected unknown format error, got %v", err)
+		}
+	})
+
+	t.Run("incompatible field type", func(t *testing.T) {
+		type bad struct {
+			Value bool `anytype:"value,date"`
+		}
+		if _, err := anytype.Marshal(bad{}); err == nil || !strings.Contains(err.Error(), "cannot hold") {
+			t.Errorf("Expected incompatible type error, got %v", err)
+		}
+	})
+
+	t.Run("format mismatch", func(t *testing.T) {
+		var task struct {
+			Estimate int `anytype:"estimate"`
+		}
+		object := &anytype.Object{Properties: []anytype.PropertyWithValue{
+			{Key: "estimate", Format: anytype.PropertyFormatText, Value: anytype.TextValue{Text: "three"}},
+		}}
+		if err := anytype.Unmarshal(object, &task); err == nil || !strings.Contains(err.Error(), "field expects") {
+			t.Errorf("Expected format mismatch error, got %v", err)
+		}
+	})
+
+	t.Run("non-pointer target", func(t *testing.T) {
+		if err := anytype.Unmarshal(&anytype.Object{}, codecTask{}); err == nil {
+			t.Error("Expected an error for a non-pointer target")
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/codegen"
+)
+
+// TestCodegenGenerate tests generating models and repositories from types
+func TestCodegenGenerate(t *testing.T) {
+	spaceTypes := []anytype.Type{
+		{
+			Key:  "task",
+			Name: "Task",
+			PropertyDefinitions: []anytype.PropertyDefinition{
+				{Key: "name", Name: "Name", Format: "text"},
+				{Key: "creator", Name: "Created by", Format: "objects"},
+				{Key: "due_date", Name: "Due date", Format: "date"},
+				{Key: "status", Name: "Status", Format: "select"},
+				{Key: "done", Name: "Done", Format: "checkbox"},
+				{Key: "source", Name: "Source URL", Format: "url"},
+			},
+		},
+		{
+			Key:  "meeting",
+			Name: "Meeting",
+		},
+	}
+
+	source, err := codegen.Generate(spaceTypes, codegen.Options{Package: "models"})
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "models.go", source, 0)
+	if err != nil {
+		t.Fatalf("Generated code does not parse: %v\n%s", err, source)
+	}
+
+	// Type-check against the anytype package, so that generated code calling
+	// the client with wrong names or types fails the test
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := config.Check("models", fset, []*ast.File{file}, nil); err != nil {
+		t.Fatalf("Generated code does not type-check: %v\n%s", err, source)
+	}
+
+	// Collapse gofmt alignment so fields can be matched on a single space
+	code := strings.Join(strings.Fields(string(source)), " ")
+	for _, want := range []string{
+		"package models",
+		`const TaskTypeKey = "task"`,
+		"DueDate time.Time `anytype:\"due_date,date,omitempty\"`",
+		"Status anytype.Tag `anytype:\"status,select,omitempty\"`",
+		"Done bool `anytype:\"done,checkbox\"`",
+		"SourceURL string",
+		"func (r *TaskRepo) Update(ctx context.Context, v *Task) error",
+		"func (r *MeetingRepo) List(ctx context.Context) ([]Meeting, error)",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Generated code is missing %q", want)
+		}
+	}
+
+	// Reserved and read-only properties are not emitted as fields
+	for _, unwanted := range []string{`"name,text`, `"creator,objects`} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("Generated code unexpectedly contains %q", unwanted)
+		}
+	}
+}
+
+// TestCodegenErrors tests that unsupported schemas are rejected
+func TestCodegenErrors(t *testing.T) {
+	_, err := codegen.Generate([]anytype.Type{{
+		Key:                 "task",
+		Name:                "Task",
+		PropertyDefinitions: []anytype.PropertyDefinition{{Key: "weird", Name: "Weird", Format: "hologram"}},
+	}}, codegen.Options{})
+	if err == nil || !strings.Contains(err.Error(), "unknown format") {
+		t.Errorf("Expected unknown format error, got %v", err)
+	}
+
+	if got := codegen.GoName("due_date"); got != "DueDate" {
+		t.Errorf("GoName mismatch: got %s, want DueDate", got)
+	}
+}
@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/csvio"
+)
+
+// TestCSVExportView tests exporting the objects of a list view as CSV
+func TestCSVExportView(t *testing.T) {
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/mock-space-id/lists/list-id/views/view-id/objects" {
+			t.Errorf("Unexpected request path: %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": []map[string]any{
+				{
+					"id":   "task-1",
+					"name": "Write docs",
+					"properties": []map[string]any{
+						{"key": "status", "name": "Status", "format": "select", "select": map[string]any{"id": "tag-1", "name": "Open"}},
+						{"key": "estimate", "name": "Estimate", "format": "number", "number": 2.5},
+						{"key": "due", "name": "Due", "format": "date", "date": "2025-03-01T00:00:00Z"},
+					},
+				},
+				{
+					"id":   "task-2",
+					"name": "Review, then merge",
+					"properties": []map[string]any{
+						{"key": "labels", "name": "Labels", "format": "multi_select", "multi_select": []map[string]any{{"name": "docs"}, {"name": "urgent"}}},
+						{"key": "done", "name": "Done", "format": "checkbox", "checkbox": true},
+					},
+				},
+			},
+			"pagination": map[string]any{"has_more": false},
+		})
+	}))
+	defer cleanupTestClient(tc)
+
+	var buf bytes.Buffer
+	list := tc.Client.Space(tc.SpaceID).List("list-id")
+	if err := csvio.ExportView(tc.Ctx, list, "view-id", &buf); err != nil {
+		t.Fatalf("Failed to export view: %v", err)
+	}
+
+	want := "id,name,Status,Estimate,Due,Labels,Done\n" +
+		"task-1,Write docs,Open,2.5,2025-03-01,,\n" +
+		"task-2,\"Review, then merge\",,,,\"docs, urgent\",true\n"
+	if buf.String() != want {
+		t.Errorf("Unexpected CSV:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+// TestCSVImport tests creating and upserting objects from CSV rows
+func TestCSVImport(t *testing.T) {
+	var (
+		created      []map[string]any
+		updated      = make(map[string]map[string]any)
+		createdTags  []string
+		listResponse = func(data any) map[string]any {
+			return map[string]any{"data": data, "pagination": map[string]any{"has_more": false}}
+		}
+	)
+
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+
+		path := strings.TrimPrefix(r.URL.Path, "/v1/spaces/mock-space-id")
+		switch {
+		case r.Method == http.MethodGet && path == "/properties":
+			json.NewEncoder(w).Encode(listResponse([]map[string]any{
+				{"id": "status-id", "key": "status", "name": "Status", "format": "select"},
+				{"id": "estimate-id", "key": "estimate", "name": "Estimate", "format": "number"},
+				{"id": "due-id", "key": "due", "name": "Due", "format": "date"},
+				{"id": "done-id", "key": "done", "name": "Done", "format": "checkbox"},
+				{"id": "created-id", "key": "created_date", "name": "Created", "format": "date"},
+			}))
+		case r.Method == http.MethodPost && path == "/search":
+			json.NewEncoder(w).Encode(listResponse([]map[string]any{
+				{"id": "task-2", "name": "Review"},
+				{"id": "task-3", "name": "Triage"},
+				{"id": "task-4", "name": "triage"},
+			}))
+		case r.Method == http.MethodGet && path == "/properties/status-id/tags":
+			json.NewEncoder(w).Encode(listResponse([]map[string]any{{"id": "open-id", "name": "Open"}}))
+		case r.Method == http.MethodPost && path == "/properties/status-id/tags":
+			createdTags = append(createdTags, body["name"].(string))
+			json.NewEncoder(w).Encode(map[string]any{"tag": map[string]any{"id": "closed-id", "name": body["name"]}})
+		case r.Method == http.MethodPost && path == "/objects":
+			created = append(created, body)
+			json.NewEncoder(w).Encode(map[string]any{"object": map[string]any{"id": "new-id"}})
+		case r.Method == http.MethodPatch && strings.HasPrefix(path, "/objects/"):
+			updated[strings.TrimPrefix(path, "/objects/")] = body
+			json.NewEncoder(w).Encode(map[string]any{"object": map[string]any{}})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer cleanupTestClient(tc)
+
+	input := "id,Name,status,Estimate,Due,Done,Created,Notes\n" +
+		"task-1,Write docs,open,3,2025-03-01,yes,2020-01-01,ignored\n" +
+		",Review,Closed,,,no,,\n" +
+		",Release,,1.5,,,,\n" +
+		",Broken,,soon,,,,\n" +
+		",Triage,,2,,,,\n"
+
+	result, err := csvio.Import(tc.Ctx, tc.Client.Space(tc.SpaceID), "task", strings.NewReader(input), csvio.Mapping{Upsert: true})
+	if err != nil {
+		t.Fatalf("Failed to import CSV: %v", err)
+	}
+
+	if result.Created != 1 || result.Updated != 2 {
+		t.Errorf("Expected 1 created and 2 updated objects, got %+v", result)
+	}
+	if len(result.Failed) != 2 || result.Failed[0].Row != 5 || !strings.Contains(result.Failed[0].Error(), `invalid number "soon"`) {
+		t.Fatalf("Expected rows 5 and 6 to fail, got %v", result.Failed)
+	}
+
+	// Names matching several objects are not updated
+	if result.Failed[1].Row != 6 || !errors.Is(result.Failed[1], anytype.ErrConflict) {
+		t.Errorf("Expected row 6 to fail with a conflict, got %v", result.Failed[1])
+	}
+	if _, ok := updated["task-3"]; ok {
+		t.Error("Expected no object named Triage to be updated")
+	}
+	if _, ok := updated["task-4"]; ok {
+		t.Error("Expected no object named Triage to be updated")
+	}
+
+	// Matched by ID, read-only and unknown columns ignored
+	byID, _ := json.Marshal(updated["task-1"]["properties"])
+	want := `[{"key":"status","select":"open-id"},{"key":"estimate","number":3},{"date":"2025-03-01T00:00:00Z","key":"due"},{"checkbox":true,"key":"done"}]`
+	if string(byID) != want {
+		t.Errorf("Unexpected properties of task-1:\n%s\nwant:\n%s", byID, want)
+	}
+
+	// Matched by name, with a new tag
+	byName, _ := json.Marshal(updated["task-2"]["properties"])
+	if string(byName) != `[{"key":"status","select":"closed-id"},{"checkbox":false,"key":"done"}]` {
+		t.Errorf("Unexpected properties of task-2: %s", byName)
+	}
+	if len(createdTags) != 1 || createdTags[0] != "Closed" {
+		t.Errorf("Expected the Closed tag to be created, got %v", createdTags)
+	}
+
+	if len(created) != 1 || created[0]["Name"] != "Release" || created[0]["type_key"] != "task" {
+		t.Errorf("Unexpected created objects: %v", created)
+	}
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// TestAPIErrors tests that error responses are parsed into typed API errors
+func TestAPIErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		body     string
+		sentinel error
+		code     string
+	}{
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"code":"object_not_found","message":"Resource not found","object":"error","status":404}`,
+			sentinel: anytype.ErrNotFound,
+			code:     "object_not_found",
+		},
+		{
+			name:     "gone",
+			status:   http.StatusGone,
+			body:     `{"code":"resource_gone","message":"Resource is gone","object":"error","status":410}`,
+			sentinel: anytype.ErrGone,
+			code:     "resource_gone",
+		},
+		{
+			name:     "unauthorized",
+			status:   http.StatusUnauthorized,
+			body:     `{"code":"unauthorized","message":"Unauthorized","object":"error","status":401}`,
+			sentinel: anytype.ErrUnauthorized,
+			code:     "unauthorized",
+		},
+		{
+			name:     "validation",
+			status:   http.StatusBadRequest,
+			body:     `{"code":"bad_request","message":"Bad request","object":"error","status":400}`,
+			sentinel: anytype.ErrValidation,
+			code:     "bad_request",
+		},
+		{
+			name:     "plain text body",
+			status:   http.StatusForbidden,
+			body:     "forbidden",
+			sentinel: anytype.ErrForbidden,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer cleanupTestClient(tc)
+
+			_, err := tc.Client.Space(tc.SpaceID).Object("missing-object-id").Get(tc.Ctx)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("Expected errors.Is(err, %v) to be true, got %v", tt.sentinel, err)
+			}
+
+			var apiErr *anytype.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected an *anytype.APIError, got %T", err)
+			}
+
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("Status code mismatch: got %d, want %d", apiErr.StatusCode, tt.status)
+			}
+
+			if apiErr.Code != tt.code {
+				t.Errorf("Error code mismatch: got %q, want %q", apiErr.Code, tt.code)
+			}
+		})
+	}
+}
+
+// TestAPIErrorSentinels tests status code to sentinel error mapping
+func TestAPIErrorSentinels(t *testing.T) {
+	err := anytype.NewAPIError(http.StatusServiceUnavailable, nil)
+	if !errors.Is(err, anytype.ErrServer) {
+		t.Errorf("Expected 503 to match ErrServer")
+	}
+
+	err = anytype.NewAPIError(http.StatusTooManyRequests, []byte(`{"code":"rate_limit_exceeded","message":"Rate limit exceeded"}`))
+	if !errors.Is(err, anytype.ErrRateLimited) {
+		t.Errorf("Expected 429 to match ErrRateLimited")
+	}
+	if errors.Is(err, anytype.ErrNotFound) {
+		t.Errorf("Expected 429 not to match ErrNotFound")
+	}
+
+	if !anytype.IsNotFound(anytype.NewAPIError(http.StatusGone, nil)) {
+		t.Errorf("Expected IsNotFound to be true for 410")
+	}
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// TestExportFormats tests exporting an object to each built-in format
+func TestExportFormats(t *testing.T) {
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"object": map[string]any{
+				"id":       "note-id",
+				"name":     "Weekly notes",
+				"type":     map[string]any{"key": "page"},
+				"markdown": "# Plans\n\nShip **the** release\n\n- one\n- `two`",
+				"properties": []map[string]any{
+					{"key": "status", "format": "text", "text": "draft"},
+					{"key": "done", "format": "checkbox", "checkbox": true},
+				},
+			},
+		})
+	}))
+	defer cleanupTestClient(tc)
+
+	note := tc.Client.Space(tc.SpaceID).Object("note-id")
+
+	tests := []struct {
+		format anytype.ExportFormat
+		want   []string
+	}{
+		{anytype.ExportFormatMarkdown, []string{"---\nid: \"note-id\"\n", "type: \"page\"\n", "status: \"draft\"\ndone: true\n---\n\n# Plans"}},
+		{anytype.ExportFormatJSON, []string{`"id": "note-id"`, `"name": "Weekly notes"`}},
+		{anytype.ExportFormatHTML, []string{"<title>Weekly notes</title>", "<h1>Plans</h1>", "<p>Ship <strong>the</strong> release</p>", "<ul>\n<li>one</li>\n<li><code>two</code></li>\n</ul>"}},
+		{anytype.ExportFormatText, []string{"Weekly notes\n\nPlans\n\nShip the release\n\n- one\n- two\n"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			result, err := note.Export(tc.Ctx, tt.format)
+			if err != nil {
+				t.Fatalf("Failed to export object: %v", err)
+			}
+
+			if result.Format != tt.format {
+				t.Errorf("Expected format %q, got %q", tt.format, result.Format)
+			}
+			if !strings.HasPrefix(result.Markdown, "# Plans") {
+				t.Errorf("Expected the markdown body to be returned, got %q", result.Markdown)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(result.Content, want) {
+					t.Errorf("Expected export to contain %q, got:\n%s", want, result.Content)
+				}
+			}
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := note.Export(tc.Ctx, "docx")
+		if !errors.Is(err, anytype.ErrUnsupported) {
+			t.Errorf("Expected ErrUnsupported, got %v", err)
+		}
+	})
+}
+
+// TestExportHTMLLinks tests that only safe link targets are exported as links
+func TestExportHTMLLinks(t *testing.T) {
+	tests := []struct {
+		markdown string
+		want     string
+	}{
+		{"[docs](https://anytype.io/docs?a=1&b=2)", `<a href="https://anytype.io/docs?a=1&amp;b=2">docs</a>`},
+		{"[mail](mailto:team@anytype.io)", `<a href="mailto:team@anytype.io">mail</a>`},
+		{"[notes](../notes/plan.md#goals)", `<a href="../notes/plan.md#goals">notes</a>`},
+		{"[wiki](https://en.wikipedia.org/wiki/Go_(programming_language))", `<a href="https://en.wikipedia.org/wiki/Go_(programming_language)">wiki</a>`},
+		{"[me](javascript:alert(document.cookie))", "<p>[me](javascript:alert(document.cookie))</p>"},
+		{"[me](JavaScript:alert(1))", "<p>[me](JavaScript:alert(1))</p>"},
+		{`[me](data:text/html,<script>alert(1)</script>)`, "<p>[me](data:text/html,&lt;script&gt;alert(1)&lt;/script&gt;)</p>"},
+	}
+
+	for _, tt := range tests {
+		result, err := anytype.ExportObject(&anytype.Object{Markdown: tt.markdown}, anytype.ExportFormatHTML)
+		if err != nil {
+			t.Fatalf("Failed to export object: %v", err)
+		}
+		if !strings.Contains(result.Content, tt.want) {
+			t.Errorf("Expected export of %q to contain %q, got:\n%s", tt.markdown, tt.want, result.Content)
+		}
+		if strings.Contains(strings.ToLower(result.Content), `href="javascript:`) || strings.Contains(result.Content, `href="data:`) {
+			t.Errorf("Expected unsafe link %q to be exported as text, got:\n%s", tt.markdown, result.Content)
+		}
+	}
+}
+
+// TestRegisterExporter tests adding a custom export format
+func TestRegisterExporter(t *testing.T) {
+	const csv anytype.ExportFormat = "csv"
+	anytype.RegisterExporter(csv, anytype.ExporterFunc(func(obj *anytype.Object) (string, error) {
+		return obj.ID + "," + obj.Name + "\n", nil
+	}))
+
+	found := false
+	for _, format := range anytype.ExportFormats() {
+		if format == csv {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected %q in the registered formats", csv)
+	}
+
+	result, err := anytype.ExportObject(&anytype.Object{ID: "note-id", Name: "Weekly notes"}, csv)
+	if err != nil {
+		t.Fatalf("Failed to export object: %v", err)
+	}
+	if result.Content != "note-id,Weekly notes\n" {
+		t.Errorf("Unexpected export content: %q", result.Content)
+	}
+}
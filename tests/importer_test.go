@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go/importer"
+)
+
+// TestImportVault tests importing markdown notes with front matter and wikilinks
+func TestImportVault(t *testing.T) {
+	dir := t.TempDir()
+	notes := map[string]string{
+		"Projects/Alpha.md": "---\nstatus: Active\ntags: [work, \"urgent\"]\nrating: 4\nhomepage: https://example.com\nrelated: \"[[Beta]]\"\n---\n\nLinked to [[b]] and [[Missing]].\n",
+		"Beta.md":           "---\ntitle: Beta\naliases:\n  - B\n---\nBack to [[Projects/Alpha|alpha]]\n",
+		"Existing.md":       "Already imported\n",
+		"Broken.md":         "---\nstatus: Active\n",
+		"Empty.md":          "---\n---\nNo properties\n\n---\n\nAfter a rule\n",
+		"Dashes.md":         "---\nstatus: Active\n----\nBody\n",
+		"Symbols.md":        "---\n\"???\": yes\n---\nBody\n",
+		".obsidian/app.md":  "ignored\n",
+	}
+	for name, content := range notes {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var (
+		createdProperties []string
+		createdTags       []string
+		bodies            = make(map[string]string)
+		properties        = make(map[string][]map[string]any)
+		links             = make(map[string][]map[string]any)
+	)
+
+	list := func(data any) map[string]any {
+		return map[string]any{"data": data, "pagination": map[string]any{"has_more": false}}
+	}
+
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, "/v1/spaces/mock-space-id")
+		switch {
+		case r.Method == http.MethodGet && path == "/properties":
+			json.NewEncoder(w).Encode(list([]map[string]any{{"id": "status-id", "key": "status", "format": "select"}}))
+		case r.Method == http.MethodPost && path == "/properties":
+			key := body["key"].(string)
+			createdProperties = append(createdProperties, key+":"+body["format"].(string))
+			json.NewEncoder(w).Encode(map[string]any{"property": map[string]any{"id": key + "-id", "key": key, "format": body["format"]}})
+		case r.Method == http.MethodGet && path == "/properties/status-id/tags":
+			json.NewEncoder(w).Encode(list([]map[string]any{{"id": "active-id", "name": "Active"}}))
+		case r.Method == http.MethodGet && strings.HasSuffix(path, "/tags"):
+			json.NewEncoder(w).Encode(list([]map[string]any{}))
+		case r.Method == http.MethodPost && strings.HasSuffix(path, "/tags"):
+			name := body["name"].(string)
+			createdTags = append(createdTags, name)
+			json.NewEncoder(w).Encode(map[string]any{"tag": map[string]any{"id": name + "-id", "name": name}})
+		case r.Method == http.MethodPost && path == "/search":
+			json.NewEncoder(w).Encode(list([]map[string]any{{"id": "existing-id", "name": "Existing"}}))
+		case r.Method == http.MethodPost && path == "/objects":
+			id := strings.ToLower(body["Name"].(string)) + "-id"
+			bodies[id] = body["Body"].(string)
+			values, _ := body["properties"].([]any)
+			for _, p := range values {
+				properties[id] = append(properties[id], p.(map[string]any))
+			}
+			json.NewEncoder(w).Encode(map[string]any{"object": map[string]any{"id": id}})
+		case r.Method == http.MethodPatch && strings.HasPrefix(path, "/objects/"):
+			id := strings.TrimPrefix(path, "/objects/")
+			for _, p := range body["properties"].([]any) {
+				links[id] = append(links[id], p.(map[string]any))
+			}
+			json.NewEncoder(w).Encode(map[string]any{"object": map[string]any{"id": id}})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer cleanupTestClient(tc)
+
+	report, err := importer.Vault(tc.Ctx, tc.Client.Space(tc.SpaceID), dir, importer.Options{})
+	if err != nil {
+		t.Fatalf("Failed to import vault: %v", err)
+	}
+
+	entries := func(entries []importer.Entry) []string {
+		var paths []string
+		for _, entry := range entries {
+			paths = append(paths, entry.Path)
+		}
+		return paths
+	}
+	if got := entries(report.Created); !reflect.DeepEqual(got, []string{"Beta.md", "Empty.md", "Projects/Alpha.md"}) {
+		t.Errorf("Unexpected created notes: %v", got)
+	}
+	if got := entries(report.Skipped); !reflect.DeepEqual(got, []string{"Existing.md"}) {
+		t.Errorf("Unexpected skipped notes: %v", got)
+	}
+	if got := entries(report.Failed); !reflect.DeepEqual(got, []string{"Broken.md", "Dashes.md", "Symbols.md"}) {
+		t.Errorf("Unexpected failed notes: %v", got)
+	}
+
+	wantProperties := []string{"tags:multi_select", "rating:number", "homepage:url", "related:objects", "linked_notes:objects"}
+	if !reflect.DeepEqual(createdProperties, wantProperties) {
+		t.Errorf("Unexpected created properties: %v", createdProperties)
+	}
+	if !reflect.DeepEqual(createdTags, []string{"work", "urgent"}) {
+		t.Errorf("Unexpected created tags: %v", createdTags)
+	}
+
+	if bodies["alpha-id"] != "Linked to [[b]] and [[Missing]].\n" {
+		t.Errorf("Unexpected body: %q", bodies["alpha-id"])
+	}
+	if bodies["empty-id"] != "No properties\n\n---\n\nAfter a rule\n" {
+		t.Errorf("Expected empty front matter to keep the whole body, got %q", bodies["empty-id"])
+	}
+	if status := properties["alpha-id"][0]; status["key"] != "status" || status["select"] != "active-id" {
+		t.Errorf("Expected the existing Active tag to be selected, got %v", status)
+	}
+
+	// Links are resolved by alias, path and front matter value
+	alphaLinks, _ := json.Marshal(links["alpha-id"])
+	if string(alphaLinks) != `[{"key":"linked_notes","objects":["beta-id"]},{"key":"related","objects":["beta-id"]}]` {
+		t.Errorf("Unexpected links of Alpha: %s", alphaLinks)
+	}
+	betaLinks, _ := json.Marshal(links["beta-id"])
+	if string(betaLinks) != `[{"key":"linked_notes","objects":["alpha-id"]}]` {
+		t.Errorf("Unexpected links of Beta: %s", betaLinks)
+	}
+
+	if len(report.Unresolved) != 1 || report.Unresolved[0].Target != "Missing" {
+		t.Errorf("Expected the link to Missing to be unresolved, got %v", report.Unresolved)
+	}
+}
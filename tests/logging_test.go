@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
+// logRecords decodes the records written by a slog JSON handler
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Failed to decode log record %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// TestClientLogging tests the request records and the redaction of credentials
+func TestClientLogging(t *testing.T) {
+	var buf bytes.Buffer
+	config := middleware.DefaultLoggingConfig()
+	config.Logger = slog.New(slog.NewJSONHandler(&buf, nil))
+	config.LogHeaders = true
+	config.LogBodies = true
+
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/api_keys":
+			w.Write([]byte(`{"api_key":"secret-api-key"}`))
+		default:
+			w.Write([]byte(`{"object":{"id":"object-id","name":"Note"}}`))
+		}
+	}), anytype.WithLoggingConfig(config))
+	defer cleanupTestClient(tc)
+
+	if _, err := tc.Client.Auth().CreateApiKey(tc.Ctx, "challenge-id", "4321"); err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	if _, err := tc.Client.Space(tc.SpaceID).Object("object-id").Get(tc.Ctx); err != nil {
+		t.Fatalf("Failed to get object: %v", err)
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{"secret-api-key", "4321", "test-app-key"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Expected %q to be redacted from the logs:\n%s", secret, logged)
+		}
+	}
+
+	records := logRecords(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d", len(records))
+	}
+
+	auth := records[0]
+	if auth["method"] != "POST" || auth["path"] != "/v1/auth/api_keys" || auth["status"] != float64(200) {
+		t.Errorf("Unexpected record: %v", auth)
+	}
+	if auth["response_size"] != float64(len(`{"api_key":"secret-api-key"}`)) {
+		t.Errorf("Unexpected response size: %v", auth["response_size"])
+	}
+	if auth["request_body"] != `{"challenge_id":"challenge-id","code":"[REDACTED]"}` {
+		t.Errorf("Unexpected request body: %v", auth["request_body"])
+	}
+	if headers, _ := auth["headers"].(map[string]any); headers["Authorization"] != "Bearer [REDACTED]" {
+		t.Errorf("Expected the authorization header to be redacted, got %v", auth["headers"])
+	}
+	if _, ok := auth["duration"]; !ok {
+		t.Error("Expected the duration to be logged")
+	}
+
+	if path := records[1]["path"]; path != "/v1/spaces/{space_id}/objects/{object_id}" {
+		t.Errorf("Expected a path template, got %v", path)
+	}
+}
+
+// TestClientLoggingRedactsByDefault tests that credentials are redacted with a config built without the defaults
+func TestClientLoggingRedactsByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"api_key":"secret-api-key","owner":{"token":"secret-token"}}`))
+	}), anytype.WithLoggingConfig(middleware.LoggingConfig{
+		Logger:       slog.New(slog.NewJSONHandler(&buf, nil)),
+		LogBodies:    true,
+		RedactFields: []string{"token"},
+	}))
+	defer cleanupTestClient(tc)
+
+	if _, err := tc.Client.Auth().CreateApiKey(tc.Ctx, "challenge-id", "4321"); err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{"secret-api-key", "secret-token", "4321"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Expected %q to be redacted from the logs:\n%s", secret, logged)
+		}
+	}
+}
+
+// TestClientLoggingAttempts tests that each attempt of a retried request is logged
+func TestClientLoggingAttempts(t *testing.T) {
+	var requests int32
+	var buf bytes.Buffer
+
+	retryConfig := middleware.DefaultRetryConfig()
+	retryConfig.RetryDelay = time.Millisecond
+
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"space":{"id":"mock-space-id","name":"Mock Space"}}`))
+	}),
+		anytype.WithRetryConfig(retryConfig),
+		anytype.WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))),
+	)
+	defer cleanupTestClient(tc)
+
+	if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err != nil {
+		t.Fatalf("Failed to get space: %v", err)
+	}
+
+	records := logRecords(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d", len(records))
+	}
+	for i, want := range []struct {
+		level   string
+		attempt float64
+		status  float64
+	}{{"WARN", 1, 503}, {"INFO", 2, 200}} {
+		record := records[i]
+		if record["level"] != want.level || record["attempt"] != want.attempt || record["status"] != want.status {
+			t.Errorf("Record %d: expected %s attempt %v with status %v, got %v", i, want.level, want.attempt, want.status, record)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"iter"
+)
+
+// sliceSeq returns an iterator over items, or yielding only err if it is not nil
+func sliceSeq[T any](items []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
@@ -2,8 +2,10 @@ package mocks
 
 import (
 	"context"
+	"iter"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // MockMembersService implements the anytype.MemberClient interface for testing
@@ -34,6 +36,15 @@ func (s *MockMembersService) List(ctx context.Context) (*anytype.MemberListRespo
 	return s.ListFunc(ctx)
 }
 
+// All iterates over the members returned by the mock List implementation
+func (s *MockMembersService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Member, error] {
+	resp, err := s.ListFunc(ctx)
+	if err != nil {
+		return sliceSeq[anytype.Member](nil, err)
+	}
+	return sliceSeq(resp.Data, nil)
+}
+
 // Member returns a mock member service
 func (s *MockMembersService) Member(memberID string) anytype.MemberContext {
 	return NewMockMemberService(memberID)
@@ -141,6 +152,15 @@ func (s *MockViewsService) List(ctx context.Context) (*anytype.ViewListResponse,
 	return s.ListFunc(ctx)
 }
 
+// All iterates over the views returned by the mock List implementation
+func (s *MockViewsService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.ListView, error] {
+	resp, err := s.ListFunc(ctx)
+	if err != nil {
+		return sliceSeq[anytype.ListView](nil, err)
+	}
+	return sliceSeq(resp.Data, nil)
+}
+
 // MockViewService implements the anytype.ViewContext interface for testing
 type MockViewService struct {
 	ViewID         string
@@ -192,6 +212,15 @@ func (s *MockViewObjectsService) List(ctx context.Context) (*anytype.ObjectListR
 	return s.ListFunc(ctx)
 }
 
+// All iterates over the objects returned by the mock List implementation
+func (s *MockViewObjectsService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	resp, err := s.ListFunc(ctx)
+	if err != nil {
+		return sliceSeq[anytype.Object](nil, err)
+	}
+	return sliceSeq(resp.Data, nil)
+}
+
 // MockListObjectsService implements the anytype.ObjectListClient interface for testing
 type MockListObjectsService struct {
 	AddFunc  func(ctx context.Context, objectIDs []string) error
@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"context"
+	"fmt"
+	"iter"
 
 	"github.com/rubiojr/anytype-go"
 	"github.com/rubiojr/anytype-go/options"
@@ -13,9 +15,10 @@ type MockObjectsService struct {
 	ListFunc        func(ctx context.Context, opts ...options.ListOption) ([]anytype.Object, error)
 	CreateFunc      func(ctx context.Context, req anytype.CreateObjectRequest) (*anytype.ObjectResponse, error)
 	GetFunc         func(ctx context.Context) (*anytype.ObjectResponse, error)
-	ExportFunc      func(ctx context.Context, format string) (*anytype.ExportResult, error)
+	ExportFunc      func(ctx context.Context, format anytype.ExportFormat) (*anytype.ExportResult, error)
 	DeleteFunc      func(ctx context.Context) (*anytype.ObjectResponse, error)
 	UpdateFunc      func(ctx context.Context, req anytype.UpdateObjectRequest) error
+	UpsertFunc      func(ctx context.Context, key anytype.UpsertKey, req anytype.CreateObjectRequest) (*anytype.UpsertResponse, error)
 }
 
 // NewMockObjectsService creates a new instance of MockObjectsService with default implementations
@@ -82,10 +85,12 @@ func NewMockObjectsService() *MockObjectsService {
 				},
 			}, nil
 		},
-		ExportFunc: func(ctx context.Context, format string) (*anytype.ExportResult, error) {
-			return &anytype.ExportResult{
+		ExportFunc: func(ctx context.Context, format anytype.ExportFormat) (*anytype.ExportResult, error) {
+			return anytype.ExportObject(&anytype.Object{
+				ID:       "mock-object-id",
+				Name:     "Mock Object",
 				Markdown: "# Mock Object\n\nThis is the content of a mock object exported as markdown.",
-			}, nil
+			}, format)
 		},
 		DeleteFunc: func(ctx context.Context) (*anytype.ObjectResponse, error) {
 			return &anytype.ObjectResponse{
@@ -111,11 +116,29 @@ func (s *MockObjectsService) List(ctx context.Context, opts ...options.ListOptio
 	return s.ListFunc(ctx, opts...)
 }
 
+// All iterates over the objects returned by the mock List implementation
+func (s *MockObjectsService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	return sliceSeq(s.ListFunc(ctx, opts...))
+}
+
 // Create calls the mock implementation
 func (s *MockObjectsService) Create(ctx context.Context, req anytype.CreateObjectRequest) (*anytype.ObjectResponse, error) {
 	return s.CreateFunc(ctx, req)
 }
 
+// Upsert calls the mock implementation, creating the object by default
+func (s *MockObjectsService) Upsert(ctx context.Context, key anytype.UpsertKey, req anytype.CreateObjectRequest) (*anytype.UpsertResponse, error) {
+	if s.UpsertFunc != nil {
+		return s.UpsertFunc(ctx, key, req)
+	}
+
+	resp, err := s.CreateFunc(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &anytype.UpsertResponse{Object: resp.Object, Created: true}, nil
+}
+
 // Get calls the mock implementation
 func (s *MockObjectsService) Get(ctx context.Context) (*anytype.ObjectResponse, error) {
 	// Override the mock implementation to respect CurrentObjectID if it's set
@@ -135,15 +158,17 @@ func (s *MockObjectsService) Get(ctx context.Context) (*anytype.ObjectResponse,
 }
 
 // Export implements the ExportFunc for the mock
-func (s *MockObjectsService) Export(ctx context.Context, format string) (*anytype.ExportResult, error) {
+func (s *MockObjectsService) Export(ctx context.Context, format anytype.ExportFormat) (*anytype.ExportResult, error) {
 	if s.ExportFunc != nil {
 		return s.ExportFunc(ctx, format)
 	}
 
-	// By default, return a mock markdown result
-	return &anytype.ExportResult{
+	// By default, export a mock object
+	return anytype.ExportObject(&anytype.Object{
+		ID:       "mock-object-id",
+		Name:     "Mock Object",
 		Markdown: "# Mock Object\n\nThis is the content of a mock object exported as markdown.",
-	}, nil
+	}, format)
 }
 
 // Delete calls the mock implementation
@@ -156,8 +181,74 @@ func (s *MockObjectsService) Update(ctx context.Context, req anytype.UpdateObjec
 	return s.UpdateFunc(ctx, req)
 }
 
+// Blocks returns a mock block client parsing the mock markdown body
+func (s *MockObjectsService) Blocks() anytype.BlockClient {
+	return NewMockBlockClient("# Mock Object\n\nThis is the content of a mock object exported as markdown.")
+}
+
 // Object returns a mock object service for a specific object
 func (s *MockObjectsService) Object(objectID string) anytype.ObjectContext {
 	s.SetCurrentObjectID(objectID)
 	return s
 }
+
+// MockBlockClient implements the anytype.BlockClient interface for testing
+type MockBlockClient struct {
+	ListFunc   func(ctx context.Context) ([]anytype.Block, error)
+	CreateFunc func(ctx context.Context, req anytype.CreateBlockRequest) (*anytype.Block, error)
+	UpdateFunc func(ctx context.Context, blockID string, req anytype.UpdateBlockRequest) error
+	DeleteFunc func(ctx context.Context, blockID string) error
+}
+
+// NewMockBlockClient creates a new instance of MockBlockClient serving the
+// blocks of the given markdown, rejecting edits like the real client
+func NewMockBlockClient(markdown string) *MockBlockClient {
+	return &MockBlockClient{
+		ListFunc: func(ctx context.Context) ([]anytype.Block, error) {
+			return anytype.BlocksFromMarkdown(markdown), nil
+		},
+		CreateFunc: func(ctx context.Context, req anytype.CreateBlockRequest) (*anytype.Block, error) {
+			return nil, fmt.Errorf("creating blocks: %w", anytype.ErrUnsupported)
+		},
+		UpdateFunc: func(ctx context.Context, blockID string, req anytype.UpdateBlockRequest) error {
+			return fmt.Errorf("updating blocks: %w", anytype.ErrUnsupported)
+		},
+		DeleteFunc: func(ctx context.Context, blockID string) error {
+			return fmt.Errorf("deleting blocks: %w", anytype.ErrUnsupported)
+		},
+	}
+}
+
+// List calls the mock implementation
+func (c *MockBlockClient) List(ctx context.Context) ([]anytype.Block, error) {
+	return c.ListFunc(ctx)
+}
+
+// Get looks up a block among the ones returned by the mock List implementation
+func (c *MockBlockClient) Get(ctx context.Context, blockID string) (*anytype.Block, error) {
+	blocks, err := c.ListFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		if block.ID == blockID {
+			return &block, nil
+		}
+	}
+	return nil, fmt.Errorf("block %q: %w", blockID, anytype.ErrNotFound)
+}
+
+// Create calls the mock implementation
+func (c *MockBlockClient) Create(ctx context.Context, req anytype.CreateBlockRequest) (*anytype.Block, error) {
+	return c.CreateFunc(ctx, req)
+}
+
+// Update calls the mock implementation
+func (c *MockBlockClient) Update(ctx context.Context, blockID string, req anytype.UpdateBlockRequest) error {
+	return c.UpdateFunc(ctx, blockID, req)
+}
+
+// Delete calls the mock implementation
+func (c *MockBlockClient) Delete(ctx context.Context, blockID string) error {
+	return c.DeleteFunc(ctx, blockID)
+}
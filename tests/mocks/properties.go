@@ -2,22 +2,27 @@ package mocks
 
 import (
 	"context"
+	"errors"
+	"iter"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // MockSpacePropertyClient implements the anytype.SpacePropertyClient interface for testing
 type MockSpacePropertyClient struct {
-	CreateFunc func(ctx context.Context, req anytype.CreatePropertyRequest) (*anytype.PropertyResponse, error)
-	ListFunc   func(ctx context.Context) ([]anytype.Property, error)
+	CreateFunc   func(ctx context.Context, req anytype.CreatePropertyRequest) (*anytype.PropertyResponse, error)
+	ListFunc     func(ctx context.Context) ([]anytype.Property, error)
+	GetByKeyFunc func(ctx context.Context, key string) (*anytype.Property, error)
 }
 
 // NewMockSpacePropertyClient creates a new instance of MockSpacePropertyClient with default implementations
 func NewMockSpacePropertyClient() *MockSpacePropertyClient {
-	return &MockSpacePropertyClient{
+	c := &MockSpacePropertyClient{
 		CreateFunc: func(ctx context.Context, req anytype.CreatePropertyRequest) (*anytype.PropertyResponse, error) {
 			return &anytype.PropertyResponse{
 				Property: anytype.Property{
+					ID:     "new-mock-property-id",
 					Key:    req.Key,
 					Name:   req.Name,
 					Format: req.Format,
@@ -39,6 +44,19 @@ func NewMockSpacePropertyClient() *MockSpacePropertyClient {
 			}, nil
 		},
 	}
+	c.GetByKeyFunc = func(ctx context.Context, key string) (*anytype.Property, error) {
+		properties, err := c.ListFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, property := range properties {
+			if property.Key == key {
+				return &property, nil
+			}
+		}
+		return nil, errors.New("property not found")
+	}
+	return c
 }
 
 // Create calls the mock implementation
@@ -50,3 +68,13 @@ func (c *MockSpacePropertyClient) Create(ctx context.Context, req anytype.Create
 func (c *MockSpacePropertyClient) List(ctx context.Context) ([]anytype.Property, error) {
 	return c.ListFunc(ctx)
 }
+
+// All iterates over the properties returned by the mock List implementation
+func (c *MockSpacePropertyClient) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Property, error] {
+	return sliceSeq(c.ListFunc(ctx))
+}
+
+// GetByKey calls the mock implementation
+func (c *MockSpacePropertyClient) GetByKey(ctx context.Context, key string) (*anytype.Property, error) {
+	return c.GetByKeyFunc(ctx, key)
+}
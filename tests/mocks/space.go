@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"iter"
 
 	"github.com/rubiojr/anytype-go"
 	"github.com/rubiojr/anytype-go/options"
@@ -49,14 +50,25 @@ func (s *MockSpacesService) Create(ctx context.Context, req anytype.CreateSpaceR
 	return s.CreateFunc(ctx, req)
 }
 
+// All iterates over the spaces returned by the mock List implementation
+func (s *MockSpacesService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Space, error] {
+	resp, err := s.ListFunc(ctx)
+	if err != nil {
+		return sliceSeq[anytype.Space](nil, err)
+	}
+	return sliceSeq(resp.Data, nil)
+}
+
 // MockSpaceService implements the anytype.SpaceContext interface for testing
 type MockSpaceService struct {
 	CurrentSpaceID     string
 	GetFunc            func(ctx context.Context) (*anytype.SpaceResponse, error)
+	UpdateFunc         func(ctx context.Context, req anytype.UpdateSpaceRequest) (*anytype.SpaceResponse, error)
 	MockTypeService    *MockTypeService
 	MockObjectsService *MockObjectsService
 	MockMembersService *MockMembersService
 	MockPropertyClient *MockSpacePropertyClient
+	MockProperties     map[string]*MockPropertyContext
 	MockSearchFunc     func(ctx context.Context, req anytype.SearchRequest, opts ...options.ListOption) (*anytype.SearchResponse, error)
 }
 
@@ -78,10 +90,26 @@ func NewMockSpaceService() *MockSpaceService {
 				},
 			}, nil
 		},
+		UpdateFunc: func(ctx context.Context, req anytype.UpdateSpaceRequest) (*anytype.SpaceResponse, error) {
+			space := anytype.Space{
+				ID:          "mock-space-id",
+				Name:        "Mock Space",
+				Description: "A mock space for testing",
+				Icon:        req.Icon,
+			}
+			if req.Name != nil {
+				space.Name = *req.Name
+			}
+			if req.Description != nil {
+				space.Description = *req.Description
+			}
+			return &anytype.SpaceResponse{Space: space}, nil
+		},
 		MockTypeService:    typeService,
 		MockObjectsService: objectsService,
 		MockMembersService: membersService,
 		MockPropertyClient: propertyClient,
+		MockProperties:     make(map[string]*MockPropertyContext),
 		MockSearchFunc: func(ctx context.Context, req anytype.SearchRequest, opts ...options.ListOption) (*anytype.SearchResponse, error) {
 			// Check if this is the specific search we're testing for
 			if req.Query == "UniqueTestSearchTerm2025" {
@@ -120,6 +148,11 @@ func (s *MockSpaceService) Get(ctx context.Context) (*anytype.SpaceResponse, err
 	return s.GetFunc(ctx)
 }
 
+// Update calls the mock implementation
+func (s *MockSpaceService) Update(ctx context.Context, req anytype.UpdateSpaceRequest) (*anytype.SpaceResponse, error) {
+	return s.UpdateFunc(ctx, req)
+}
+
 // Types returns the mock type service
 func (s *MockSpaceService) Types() anytype.TypeClient {
 	return s.MockTypeService
@@ -166,11 +199,31 @@ func (s *MockSpaceService) Search(ctx context.Context, req anytype.SearchRequest
 	return s.MockSearchFunc(ctx, req, opts...)
 }
 
+// SearchAll iterates over the results of the mock Search implementation
+func (s *MockSpaceService) SearchAll(ctx context.Context, req anytype.SearchRequest, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	resp, err := s.MockSearchFunc(ctx, req, opts...)
+	if err != nil {
+		return sliceSeq[anytype.Object](nil, err)
+	}
+	return sliceSeq(resp.Data, nil)
+}
+
 // Properties returns the mock property client
 func (s *MockSpaceService) Properties() anytype.SpacePropertyClient {
 	return s.MockPropertyClient
 }
 
+// Property returns the mock property context for a specific property,
+// creating it on first use so tests can configure it
+func (s *MockSpaceService) Property(propertyID string) anytype.PropertyContext {
+	property, ok := s.MockProperties[propertyID]
+	if !ok {
+		property = NewMockPropertyContext(propertyID)
+		s.MockProperties[propertyID] = property
+	}
+	return property
+}
+
 // MockSearchClient implements the anytype.SearchClient interface for testing
 type MockSearchClient struct {
 	SearchFunc func(ctx context.Context, req anytype.SearchRequest) (*anytype.SearchResponse, error)
@@ -214,3 +267,12 @@ func NewMockSearchClient() *MockSearchClient {
 func (c *MockSearchClient) Search(ctx context.Context, req anytype.SearchRequest) (*anytype.SearchResponse, error) {
 	return c.SearchFunc(ctx, req)
 }
+
+// SearchAll iterates over the results of the mock Search implementation
+func (c *MockSearchClient) SearchAll(ctx context.Context, req anytype.SearchRequest, opts ...options.ListOption) iter.Seq2[anytype.Object, error] {
+	resp, err := c.SearchFunc(ctx, req)
+	if err != nil {
+		return sliceSeq[anytype.Object](nil, err)
+	}
+	return sliceSeq(resp.Data, nil)
+}
@@ -0,0 +1,191 @@
+package mocks
+
+import (
+	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
+)
+
+// MockPropertyContext implements the anytype.PropertyContext interface for testing
+type MockPropertyContext struct {
+	PropertyID     string
+	GetFunc        func(ctx context.Context) (*anytype.PropertyResponse, error)
+	UpdateFunc     func(ctx context.Context, req anytype.UpdatePropertyRequest) (*anytype.PropertyResponse, error)
+	DeleteFunc     func(ctx context.Context) (*anytype.PropertyResponse, error)
+	MockTagClient  *MockTagClient
+	MockTagContext func(tagID string) *MockTagContext
+}
+
+// NewMockPropertyContext creates a new instance of MockPropertyContext with default implementations
+func NewMockPropertyContext(propertyID string) *MockPropertyContext {
+	return &MockPropertyContext{
+		PropertyID: propertyID,
+		GetFunc: func(ctx context.Context) (*anytype.PropertyResponse, error) {
+			return &anytype.PropertyResponse{
+				Property: anytype.Property{
+					ID:     propertyID,
+					Key:    "mock-property-key",
+					Name:   "Mock Property",
+					Format: "text",
+				},
+			}, nil
+		},
+		UpdateFunc: func(ctx context.Context, req anytype.UpdatePropertyRequest) (*anytype.PropertyResponse, error) {
+			key := req.Key
+			if key == "" {
+				key = "mock-property-key"
+			}
+			return &anytype.PropertyResponse{
+				Property: anytype.Property{
+					ID:     propertyID,
+					Key:    key,
+					Name:   req.Name,
+					Format: "text",
+				},
+			}, nil
+		},
+		DeleteFunc: func(ctx context.Context) (*anytype.PropertyResponse, error) {
+			return &anytype.PropertyResponse{
+				Property: anytype.Property{
+					ID: propertyID,
+				},
+			}, nil
+		},
+		MockTagClient:  NewMockTagClient(),
+		MockTagContext: NewMockTagContext,
+	}
+}
+
+// Get calls the mock implementation
+func (p *MockPropertyContext) Get(ctx context.Context) (*anytype.PropertyResponse, error) {
+	return p.GetFunc(ctx)
+}
+
+// Update calls the mock implementation
+func (p *MockPropertyContext) Update(ctx context.Context, req anytype.UpdatePropertyRequest) (*anytype.PropertyResponse, error) {
+	return p.UpdateFunc(ctx, req)
+}
+
+// Delete calls the mock implementation
+func (p *MockPropertyContext) Delete(ctx context.Context) (*anytype.PropertyResponse, error) {
+	return p.DeleteFunc(ctx)
+}
+
+// Tags returns the mock tag client
+func (p *MockPropertyContext) Tags() anytype.TagClient {
+	return p.MockTagClient
+}
+
+// Tag returns a mock tag context for a specific tag
+func (p *MockPropertyContext) Tag(tagID string) anytype.TagContext {
+	return p.MockTagContext(tagID)
+}
+
+// MockTagClient implements the anytype.TagClient interface for testing
+type MockTagClient struct {
+	ListFunc   func(ctx context.Context) ([]anytype.Tag, error)
+	CreateFunc func(ctx context.Context, req anytype.CreateTagRequest) (*anytype.TagResponse, error)
+}
+
+// NewMockTagClient creates a new instance of MockTagClient with default implementations
+func NewMockTagClient() *MockTagClient {
+	return &MockTagClient{
+		ListFunc: func(ctx context.Context) ([]anytype.Tag, error) {
+			return []anytype.Tag{
+				{
+					ID:    "mock-tag-id-1",
+					Key:   "mock-tag-key-1",
+					Name:  "To do",
+					Color: anytype.ColorGrey,
+				},
+				{
+					ID:    "mock-tag-id-2",
+					Key:   "mock-tag-key-2",
+					Name:  "Done",
+					Color: anytype.ColorLime,
+				},
+			}, nil
+		},
+		CreateFunc: func(ctx context.Context, req anytype.CreateTagRequest) (*anytype.TagResponse, error) {
+			return &anytype.TagResponse{
+				Tag: anytype.Tag{
+					ID:    "new-mock-tag-id",
+					Name:  req.Name,
+					Color: req.Color,
+				},
+			}, nil
+		},
+	}
+}
+
+// List calls the mock implementation
+func (c *MockTagClient) List(ctx context.Context) ([]anytype.Tag, error) {
+	return c.ListFunc(ctx)
+}
+
+// All iterates over the tags returned by the mock List implementation
+func (c *MockTagClient) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Tag, error] {
+	return sliceSeq(c.ListFunc(ctx))
+}
+
+// Create calls the mock implementation
+func (c *MockTagClient) Create(ctx context.Context, req anytype.CreateTagRequest) (*anytype.TagResponse, error) {
+	return c.CreateFunc(ctx, req)
+}
+
+// MockTagContext implements the anytype.TagContext interface for testing
+type MockTagContext struct {
+	TagID      string
+	GetFunc    func(ctx context.Context) (*anytype.TagResponse, error)
+	UpdateFunc func(ctx context.Context, req anytype.UpdateTagRequest) (*anytype.TagResponse, error)
+	DeleteFunc func(ctx context.Context) (*anytype.TagResponse, error)
+}
+
+// NewMockTagContext creates a new instance of MockTagContext with default implementations
+func NewMockTagContext(tagID string) *MockTagContext {
+	return &MockTagContext{
+		TagID: tagID,
+		GetFunc: func(ctx context.Context) (*anytype.TagResponse, error) {
+			return &anytype.TagResponse{
+				Tag: anytype.Tag{
+					ID:    tagID,
+					Name:  "To do",
+					Color: anytype.ColorGrey,
+				},
+			}, nil
+		},
+		UpdateFunc: func(ctx context.Context, req anytype.UpdateTagRequest) (*anytype.TagResponse, error) {
+			return &anytype.TagResponse{
+				Tag: anytype.Tag{
+					ID:    tagID,
+					Name:  req.Name,
+					Color: req.Color,
+				},
+			}, nil
+		},
+		DeleteFunc: func(ctx context.Context) (*anytype.TagResponse, error) {
+			return &anytype.TagResponse{
+				Tag: anytype.Tag{
+					ID: tagID,
+				},
+			}, nil
+		},
+	}
+}
+
+// Get calls the mock implementation
+func (c *MockTagContext) Get(ctx context.Context) (*anytype.TagResponse, error) {
+	return c.GetFunc(ctx)
+}
+
+// Update calls the mock implementation
+func (c *MockTagContext) Update(ctx context.Context, req anytype.UpdateTagRequest) (*anytype.TagResponse, error) {
+	return c.UpdateFunc(ctx, req)
+}
+
+// Delete calls the mock implementation
+func (c *MockTagContext) Delete(ctx context.Context) (*anytype.TagResponse, error) {
+	return c.DeleteFunc(ctx)
+}
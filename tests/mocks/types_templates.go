@@ -2,8 +2,10 @@ package mocks
 
 import (
 	"context"
+	"iter"
 
 	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // MockTypeService implements the anytype.TypeClient interface for testing
@@ -63,6 +65,11 @@ func (s *MockTypeService) List(ctx context.Context) ([]anytype.Type, error) {
 	return s.ListFunc(ctx)
 }
 
+// All iterates over the types returned by the mock List implementation
+func (s *MockTypeService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Type, error] {
+	return sliceSeq(s.ListFunc(ctx))
+}
+
 // Get calls the mock implementation
 func (s *MockTypeService) Get(ctx context.Context, typeKey string) (*anytype.Type, error) {
 	return s.GetFunc(ctx, typeKey)
@@ -87,6 +94,8 @@ func (s *MockTypeService) Type(typeID string) anytype.TypeContext {
 type MockTypeContextService struct {
 	TypeID           string
 	GetFunc          func(ctx context.Context) (*anytype.TypeResponse, error)
+	UpdateFunc       func(ctx context.Context, request anytype.UpdateTypeRequest) (*anytype.TypeResponse, error)
+	DeleteFunc       func(ctx context.Context) (*anytype.TypeResponse, error)
 	TemplatesService *MockTemplatesService
 }
 
@@ -103,6 +112,31 @@ func NewMockTypeContextService(typeID string) *MockTypeContextService {
 				},
 			}, nil
 		},
+		UpdateFunc: func(ctx context.Context, request anytype.UpdateTypeRequest) (*anytype.TypeResponse, error) {
+			name := request.Name
+			if name == "" {
+				name = "Page"
+			}
+			return &anytype.TypeResponse{
+				Type: anytype.Type{
+					ID:         typeID,
+					Key:        typeID,
+					Name:       name,
+					PluralName: request.PluralName,
+					Icon:       request.Icon,
+					Layout:     request.Layout,
+				},
+			}, nil
+		},
+		DeleteFunc: func(ctx context.Context) (*anytype.TypeResponse, error) {
+			return &anytype.TypeResponse{
+				Type: anytype.Type{
+					ID:         typeID,
+					Key:        typeID,
+					IsArchived: true,
+				},
+			}, nil
+		},
 		TemplatesService: NewMockTemplatesService(),
 	}
 }
@@ -112,6 +146,16 @@ func (s *MockTypeContextService) Get(ctx context.Context) (*anytype.TypeResponse
 	return s.GetFunc(ctx)
 }
 
+// Update calls the mock implementation
+func (s *MockTypeContextService) Update(ctx context.Context, request anytype.UpdateTypeRequest) (*anytype.TypeResponse, error) {
+	return s.UpdateFunc(ctx, request)
+}
+
+// Delete calls the mock implementation
+func (s *MockTypeContextService) Delete(ctx context.Context) (*anytype.TypeResponse, error) {
+	return s.DeleteFunc(ctx)
+}
+
 // Templates returns a mock templates service
 func (s *MockTypeContextService) Templates() anytype.TemplateClient {
 	return s.TemplatesService
@@ -171,6 +215,11 @@ func (s *MockTemplatesService) List(ctx context.Context) ([]anytype.Template, er
 	return s.ListFunc(ctx)
 }
 
+// All iterates over the templates returned by the mock List implementation
+func (s *MockTemplatesService) All(ctx context.Context, opts ...options.ListOption) iter.Seq2[anytype.Template, error] {
+	return sliceSeq(s.ListFunc(ctx))
+}
+
 // Get calls the mock implementation
 func (s *MockTemplatesService) Get(ctx context.Context, templateID string) (*anytype.Template, error) {
 	return s.GetFunc(ctx, templateID)
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/options"
+)
+
+// pagedObjectsHandler serves total objects using the limit and offset query parameters
+func pagedObjectsHandler(t *testing.T, total int, requests *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if limit <= 0 {
+			t.Errorf("Expected a limit query parameter, got %q", r.URL.RawQuery)
+			limit = total
+		}
+
+		var response anytype.ObjectListResponse
+		for i := offset; i < total && i < offset+limit; i++ {
+			response.Data = append(response.Data, anytype.Object{
+				ID:   fmt.Sprintf("object-%d", i),
+				Name: fmt.Sprintf("Object %d", i),
+			})
+		}
+		response.Pagination = options.PaginationMetadata{
+			Total:   total,
+			Limit:   limit,
+			Offset:  offset,
+			HasMore: offset+limit < total,
+		}
+
+		json.NewEncoder(w).Encode(response)
+	})
+}
+
+// TestObjectIterator tests iterating over all objects in a space across pages
+func TestObjectIterator(t *testing.T) {
+	requests := 0
+	tc := setupHTTPTestClient(t, pagedObjectsHandler(t, 5, &requests))
+	defer cleanupTestClient(tc)
+
+	var ids []string
+	for obj, err := range tc.Client.Space(tc.SpaceID).Objects().All(tc.Ctx, options.WithLimit(2)) {
+		if err != nil {
+			t.Fatalf("Failed to iterate objects: %v", err)
+		}
+		ids = append(ids, obj.ID)
+	}
+
+	if len(ids) != 5 {
+		t.Fatalf("Object count mismatch: got %d, want 5", len(ids))
+	}
+
+	for i, id := range ids {
+		if want := fmt.Sprintf("object-%d", i); id != want {
+			t.Errorf("Object %d ID mismatch: got %s, want %s", i, id, want)
+		}
+	}
+
+	if requests != 3 {
+		t.Errorf("Page request count mismatch: got %d, want 3", requests)
+	}
+}
+
+// TestObjectIteratorStopsEarly tests that breaking out of the loop stops fetching pages
+func TestObjectIteratorStopsEarly(t *testing.T) {
+	requests := 0
+	tc := setupHTTPTestClient(t, pagedObjectsHandler(t, 10, &requests))
+	defer cleanupTestClient(tc)
+
+	count := 0
+	for _, err := range tc.Client.Space(tc.SpaceID).Objects().All(tc.Ctx, options.WithLimit(3)) {
+		if err != nil {
+			t.Fatalf("Failed to iterate objects: %v", err)
+		}
+		count++
+		if count == 4 {
+			break
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("Page request count mismatch: got %d, want 2", requests)
+	}
+}
+
+// TestObjectIteratorCanceled tests that iteration honours context cancellation
+func TestObjectIteratorCanceled(t *testing.T) {
+	requests := 0
+	tc := setupHTTPTestClient(t, pagedObjectsHandler(t, 10, &requests))
+	defer cleanupTestClient(tc)
+
+	ctx, cancel := context.WithCancel(tc.Ctx)
+	defer cancel()
+
+	var iterErr error
+	for _, err := range tc.Client.Space(tc.SpaceID).Objects().All(ctx, options.WithLimit(5)) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		cancel()
+	}
+
+	if !errors.Is(iterErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", iterErr)
+	}
+}
@@ -61,3 +61,56 @@ func TestCreateProperty(t *testing.T) {
 
 	t.Logf("Successfully created property: %s (key: %s, format: %s)", propResp.Property.Name, propResp.Property.Key, propResp.Property.Format)
 }
+
+// TestPropertyLifecycle tests getting, renaming and deleting a space property
+func TestPropertyLifecycle(t *testing.T) {
+	tc := setupTestClient(t)
+	defer cleanupTestClient(tc)
+
+	spaceID := findOrCreateTestSpace(t, tc)
+
+	// Look up a property by key
+	property, err := tc.Client.Space(spaceID).Properties().GetByKey(tc.Ctx, "mock-number-property")
+	if err != nil {
+		t.Fatalf("Failed to get property by key: %v", err)
+	}
+
+	if property.Format != "number" {
+		t.Errorf("Property format mismatch: got %s, want number", property.Format)
+	}
+
+	if _, err := tc.Client.Space(spaceID).Properties().GetByKey(tc.Ctx, "missing-property"); err == nil {
+		t.Error("Expected an error for a missing property key")
+	}
+
+	propertyID := "mock-property-id"
+
+	// Get property details
+	propResp, err := tc.Client.Space(spaceID).Property(propertyID).Get(tc.Ctx)
+	if err != nil {
+		t.Fatalf("Failed to get property: %v", err)
+	}
+
+	if propResp.Property.ID != propertyID {
+		t.Errorf("Property ID mismatch: got %s, want %s", propResp.Property.ID, propertyID)
+	}
+
+	// Rename the property
+	updateReq := anytype.UpdatePropertyRequest{
+		Name: "Renamed Property",
+	}
+
+	updated, err := tc.Client.Space(spaceID).Property(propertyID).Update(tc.Ctx, updateReq)
+	if err != nil {
+		t.Fatalf("Failed to update property: %v", err)
+	}
+
+	if updated.Property.Name != updateReq.Name {
+		t.Errorf("Property name mismatch: got %s, want %s", updated.Property.Name, updateReq.Name)
+	}
+
+	// Delete the property
+	if _, err := tc.Client.Space(spaceID).Property(propertyID).Delete(tc.Ctx); err != nil {
+		t.Fatalf("Failed to delete property: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// TestPropertyValueDecoding tests decoding object properties based on their format
+func TestPropertyValueDecoding(t *testing.T) {
+	data := `{
+		"id": "object-id",
+		"name": "Task",
+		"properties": [
+			{"key": "done", "format": "checkbox", "checkbox": false},
+			{"key": "estimate", "format": "number", "number": 0},
+			{"key": "due_date", "format": "date", "date": "2025-02-14T12:34:56Z"},
+			{"key": "status", "format": "select", "select": {"id": "tag-id", "name": "Open", "color": "blue"}},
+			{"key": "labels", "format": "multi_select", "multi_select": [{"id": "a"}, {"id": "b"}]},
+			{"key": "future", "format": "hologram", "hologram": 1}
+		]
+	}`
+
+	var object anytype.Object
+	if err := json.Unmarshal([]byte(data), &object); err != nil {
+		t.Fatalf("Failed to decode object: %v", err)
+	}
+
+	if len(object.Properties) != 6 {
+		t.Fatalf("Property count mismatch: got %d, want 6", len(object.Properties))
+	}
+
+	if v, ok := object.Properties[0].Value.(anytype.CheckboxValue); !ok || v.Checkbox {
+		t.Errorf("Unexpected checkbox value: %#v", object.Properties[0].Value)
+	}
+
+	if v, ok := object.Properties[1].Value.(anytype.NumberValue); !ok || v.Number != 0 {
+		t.Errorf("Unexpected number value: %#v", object.Properties[1].Value)
+	}
+
+	want := time.Date(2025, 2, 14, 12, 34, 56, 0, time.UTC)
+	if v, ok := object.Properties[2].Value.(anytype.DateValue); !ok || !v.Date.Equal(want) {
+		t.Errorf("Unexpected date value: %#v", object.Properties[2].Value)
+	}
+
+	if v, ok := object.Properties[3].Value.(anytype.SelectValue); !ok || v.Tag == nil || v.Tag.Color != anytype.ColorBlue {
+		t.Errorf("Unexpected select value: %#v", object.Properties[3].Value)
+	}
+
+	if v, ok := object.Properties[4].Value.(anytype.MultiSelectValue); !ok || len(v.Tags) != 2 {
+		t.Errorf("Unexpected multi-select value: %#v", object.Properties[4].Value)
+	}
+
+	if object.Properties[5].Value != nil {
+		t.Errorf("Expected unknown format to decode to a nil value, got %#v", object.Properties[5].Value)
+	}
+
+	// Zero values must survive a round trip
+	encoded, err := json.Marshal(object.Properties[0])
+	if err != nil {
+		t.Fatalf("Failed to encode property: %v", err)
+	}
+
+	if string(encoded) != `{"checkbox":false,"format":"checkbox","key":"done"}` {
+		t.Errorf("Unexpected encoding: %s", encoded)
+	}
+}
+
+// TestPropertyLinkValueEncoding tests that typed values are sent in the request format
+func TestPropertyLinkValueEncoding(t *testing.T) {
+	var body map[string]json.RawMessage
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		w.Write([]byte(`{"object":{"id":"new-object-id","name":"Task"}}`))
+	}))
+	defer cleanupTestClient(tc)
+
+	_, err := tc.Client.Space(tc.SpaceID).Objects().Create(tc.Ctx, anytype.CreateObjectRequest{
+		TypeKey: "task",
+		Name:    "Task",
+		Properties: []anytype.PropertyLinkValue{
+			anytype.CheckboxProperty("done", false),
+			anytype.NumberProperty("estimate", 0),
+			anytype.DateProperty("due_date", time.Date(2025, 2, 14, 12, 34, 56, 0, time.UTC)),
+			anytype.SelectProperty("status", "tag-id"),
+			anytype.MultiSelectProperty("labels", "a", "b"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create object: %v", err)
+	}
+
+	var properties []map[string]any
+	if err := json.Unmarshal(body["properties"], &properties); err != nil {
+		t.Fatalf("Failed to decode properties: %v", err)
+	}
+
+	want := []map[string]any{
+		{"key": "done", "checkbox": false},
+		{"key": "estimate", "number": float64(0)},
+		{"key": "due_date", "date": "2025-02-14T12:34:56Z"},
+		{"key": "status", "select": "tag-id"},
+		{"key": "labels", "multi_select": []any{"a", "b"}},
+	}
+
+	got, _ := json.Marshal(properties)
+	expected, _ := json.Marshal(want)
+	if string(got) != string(expected) {
+		t.Errorf("Properties mismatch:\ngot  %s\nwant %s", got, expected)
+	}
+
+	// Link values decode back into typed values
+	var link anytype.PropertyLinkValue
+	if err := json.Unmarshal([]byte(`{"key":"status","select":"tag-id"}`), &link); err != nil {
+		t.Fatalf("Failed to decode link value: %v", err)
+	}
+
+	if v, ok := link.Value.(anytype.SelectValue); !ok || v.Tag.ID != "tag-id" {
+		t.Errorf("Unexpected link value: %#v", link.Value)
+	}
+}
@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/query"
+)
+
+// queryObjects are the objects conditions are evaluated against
+const queryObjects = `[
+	{
+		"id": "task-1",
+		"name": "Write docs",
+		"properties": [
+			{"key": "status", "format": "select", "select": {"id": "tag-done", "key": "done", "name": "Done"}},
+			{"key": "due", "format": "date", "date": "2025-03-01T00:00:00Z"},
+			{"key": "estimate", "format": "number", "number": 2},
+			{"key": "labels", "format": "multi_select", "multi_select": [{"id": "tag-docs", "name": "docs"}, {"id": "tag-urgent", "name": "urgent"}]}
+		]
+	},
+	{
+		"id": "task-2",
+		"name": "Fix login",
+		"properties": [
+			{"key": "status", "format": "select", "select": {"id": "tag-open", "key": "open", "name": "Open"}},
+			{"key": "due", "format": "date", "date": "2025-01-15T00:00:00Z"},
+			{"key": "estimate", "format": "number", "number": 5},
+			{"key": "labels", "format": "multi_select", "multi_select": [{"id": "tag-urgent", "name": "urgent"}]}
+		]
+	},
+	{
+		"id": "task-3",
+		"name": "Plan roadmap",
+		"properties": [
+			{"key": "status", "format": "select"},
+			{"key": "notes", "format": "text", "text": "Draft for the Q3 review"}
+		]
+	}
+]`
+
+// matchIDs returns the IDs of the objects matching expr
+func matchIDs(t *testing.T, expr query.Expr) string {
+	t.Helper()
+
+	var objects []anytype.Object
+	if err := json.Unmarshal([]byte(queryObjects), &objects); err != nil {
+		t.Fatalf("Failed to decode objects: %v", err)
+	}
+
+	var ids []string
+	for _, obj := range query.Filter(objects, expr) {
+		ids = append(ids, obj.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+// TestQueryMatch tests evaluating conditions against objects
+func TestQueryMatch(t *testing.T) {
+	date := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr query.Expr
+		want string
+	}{
+		{"select by name", query.Prop("status").Eq("done"), "task-1"},
+		{"select ignores case", query.Prop("status").Eq("OPEN"), "task-2"},
+		{"not equal", query.Prop("status").Ne("done"), "task-2,task-3"},
+		{"before", query.Prop("due").Before(date), "task-2"},
+		{"after", query.Prop("due").After(date), "task-1"},
+		{"number", query.Prop("estimate").Gte(3), "task-2"},
+		{"name like", query.Prop("name").Like("LOG"), "task-2"},
+		{"text like", query.Prop("notes").Like("q3"), "task-3"},
+		{"in", query.Prop("labels").In("docs", "missing"), "task-1"},
+		{"all in", query.Prop("labels").AllIn("urgent"), "task-1,task-2"},
+		{"exact in", query.Prop("labels").ExactIn("tag-urgent"), "task-2"},
+		{"empty", query.Prop("status").Empty(), "task-3"},
+		{"not empty", query.Prop("due").NotEmpty(), "task-1,task-2"},
+		{"exists", query.Prop("notes").Exists(), "task-3"},
+		{"and", query.Prop("labels").In("urgent").And(query.Prop("due").Before(date)), "task-2"},
+		{"or", query.Prop("status").Eq("done").Or(query.Prop("status").Empty()), "task-1,task-3"},
+		{"not", query.Not(query.Prop("labels").In("urgent")), "task-3"},
+		{"empty and", query.And(), "task-1,task-2,task-3"},
+		{"empty or", query.Or(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchIDs(t, tt.expr); got != tt.want {
+				t.Errorf("%s matched %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestQueryFilters tests converting conditions to and from the API filter model
+func TestQueryFilters(t *testing.T) {
+	expr := query.Prop("status").Eq("done").
+		And(query.Prop("due").Before(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))).
+		And(query.Not(query.Prop("labels").In("docs", "urgent")))
+
+	filters, err := expr.Filters()
+	if err != nil {
+		t.Fatalf("Failed to convert to filters: %v", err)
+	}
+
+	want := []anytype.ListFilter{
+		{PropertyKey: "status", Format: "text", Condition: "equal", Value: "done"},
+		{PropertyKey: "due", Format: "date", Condition: "less", Value: "2025-02-01T00:00:00Z"},
+		{PropertyKey: "labels", Format: "multi_select", Condition: "not_in", Value: "docs,urgent"},
+	}
+	if len(filters) != len(want) {
+		t.Fatalf("Expected %d filters, got %+v", len(want), filters)
+	}
+	for i := range want {
+		if filters[i] != want[i] {
+			t.Errorf("Filter %d: expected %+v, got %+v", i, want[i], filters[i])
+		}
+	}
+
+	if got := matchIDs(t, query.FromFilters(filters)); got != matchIDs(t, expr) {
+		t.Errorf("Filters matched %q, the expression matched %q", got, matchIDs(t, expr))
+	}
+
+	_, err = query.Prop("status").Eq("done").Or(query.Prop("status").Empty()).Filters()
+	if !errors.Is(err, anytype.ErrUnsupported) {
+		t.Errorf("Expected ErrUnsupported for or conditions, got %v", err)
+	}
+}
+
+// TestQueryFromViewFilters tests evaluating the filters of a view
+func TestQueryFromViewFilters(t *testing.T) {
+	expr := query.FromFilters([]anytype.ListFilter{
+		{PropertyKey: "estimate", Format: "number", Condition: "greater", Value: "1.5"},
+		{PropertyKey: "due", Format: "date", Condition: "greater_or_equal", Value: "2025-02-01"},
+		{PropertyKey: "labels", Format: "multi_select", Condition: "all_in", Value: "docs, urgent"},
+	})
+
+	if got := matchIDs(t, expr); got != "task-1" {
+		t.Errorf("Expected task-1 to match %s, got %q", expr, got)
+	}
+}
+
+// TestQueryFromDateOnlyFilters tests that date only filter values keep
+// comparing with the calendar day, like view filters do
+func TestQueryFromDateOnlyFilters(t *testing.T) {
+	var obj anytype.Object
+	if err := json.Unmarshal([]byte(`{
+		"id": "meeting-1",
+		"properties": [{"key": "due", "format": "date", "date": "2025-03-01T09:30:00+02:00"}]
+	}`), &obj); err != nil {
+		t.Fatalf("Failed to decode object: %v", err)
+	}
+
+	filter := anytype.ListFilter{PropertyKey: "due", Format: "date", Condition: "equal", Value: "2025-03-01"}
+	expr := query.FromFilters([]anytype.ListFilter{filter})
+
+	if !anytype.MatchFilter(filter, obj) {
+		t.Fatal("Expected the filter to match the object")
+	}
+	if !expr.Match(obj) {
+		t.Errorf("Expected %s to match like the filter", expr)
+	}
+
+	filters, err := expr.Filters()
+	if err != nil {
+		t.Fatalf("Failed to convert to filters: %v", err)
+	}
+	if len(filters) != 1 || filters[0] != filter {
+		t.Errorf("Expected the filter back, got %+v", filters)
+	}
+}
+
+// TestQuerySearch tests filtering search results
+func TestQuerySearch(t *testing.T) {
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/spaces/mock-space-id/search" {
+			t.Errorf("Unexpected request path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data":` + queryObjects + `,"pagination":{"has_more":false}}`))
+	}))
+	defer cleanupTestClient(tc)
+
+	var ids []string
+	space := tc.Client.Space(tc.SpaceID)
+	for obj, err := range query.Search(tc.Ctx, space, anytype.SearchRequest{Types: []string{"task"}}, query.Prop("labels").In("urgent")) {
+		if err != nil {
+			t.Fatalf("Failed to search: %v", err)
+		}
+		ids = append(ids, obj.ID)
+	}
+
+	if strings.Join(ids, ",") != "task-1,task-2" {
+		t.Errorf("Unexpected search results: %v", ids)
+	}
+}
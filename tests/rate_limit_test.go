@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
+// TestClientRateLimit tests that requests from several goroutines share the rate limit budget
+func TestClientRateLimit(t *testing.T) {
+	var requests int32
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"space":{"id":"mock-space-id","name":"Mock Space"}}`))
+	}), anytype.WithRateLimit(50, 2))
+	defer cleanupTestClient(tc)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 7; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err != nil {
+				t.Errorf("Failed to get space: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The burst covers 2 requests, the 5 others wait 20ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected requests to be throttled, took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 7 {
+		t.Errorf("Request count mismatch: got %d, want 7", got)
+	}
+}
+
+// TestClientRateLimitRetryAfter tests that a 429 response pauses requests for its Retry-After
+func TestClientRateLimitRetryAfter(t *testing.T) {
+	var requests int32
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"space":{"id":"mock-space-id","name":"Mock Space"}}`))
+	}),
+		anytype.WithRetryConfig(middleware.RetryConfig{MaxRetries: 1, RetryDelay: time.Millisecond, MaxRetryDelay: time.Millisecond}),
+		anytype.WithRateLimitConfig(middleware.RateLimitConfig{Burst: 1, MaxRetryAfter: time.Minute}),
+	)
+	defer cleanupTestClient(tc)
+
+	start := time.Now()
+	if _, err := tc.Client.Space(tc.SpaceID).Get(tc.Ctx); err != nil {
+		t.Fatalf("Failed to get space: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("Expected the retry to wait for Retry-After, took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("Request count mismatch: got %d, want 2", got)
+	}
+}
+
+// TestRateLimitCancelledRequest tests that a request cancelled while waiting gives its token back
+func TestRateLimitCancelledRequest(t *testing.T) {
+	limiter := middleware.NewRateLimitMiddleware(doerFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}), middleware.RateLimitConfig{RequestsPerSecond: 10, Burst: 1})
+
+	newRequest := func(ctx context.Context) *http.Request {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/v1/spaces", nil)
+		return req
+	}
+
+	// Use the only token of the burst
+	if _, err := limiter.Do(newRequest(context.Background())); err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Do(newRequest(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a deadline exceeded error, got %v", err)
+	}
+
+	// The next token is due 100ms after the first request, it would be
+	// 200ms if the cancelled request had kept its token
+	start := time.Now()
+	if _, err := limiter.Do(newRequest(context.Background())); err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 130*time.Millisecond {
+		t.Errorf("Expected the cancelled request to return its token, waited %v", elapsed)
+	}
+}
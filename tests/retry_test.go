@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
+// doerFunc adapts a function to the middleware.HTTPDoer interface
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// failingDoer fails every request with the given status, or err when set,
+// counting attempts
+func failingDoer(attempts *int, status int, err error) middleware.HTTPDoer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		*attempts++
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+}
+
+// fastRetryConfig returns a retry configuration with short delays
+func fastRetryConfig() middleware.RetryConfig {
+	config := middleware.DefaultRetryConfig()
+	config.MaxRetries = 3
+	config.RetryDelay = time.Millisecond
+	config.MaxRetryDelay = 5 * time.Millisecond
+	config.Budget = nil
+	return config
+}
+
+// TestRetryNonIdempotent tests that POST and PATCH requests are only retried when not received
+func TestRetryNonIdempotent(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: "http://localhost", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	readErr := &url.Error{Op: "Post", URL: "http://localhost", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}}
+
+	tests := []struct {
+		name          string
+		method        string
+		idempotentKey bool
+		status        int
+		err           error
+		retryAll      bool
+		want          int
+	}{
+		{name: "GET on 503", method: http.MethodGet, status: http.StatusServiceUnavailable, want: 4},
+		{name: "POST on 503", method: http.MethodPost, status: http.StatusServiceUnavailable, want: 1},
+		{name: "PATCH on read error", method: http.MethodPatch, err: readErr, want: 1},
+		{name: "POST on dial error", method: http.MethodPost, err: dialErr, want: 4},
+		{name: "POST on 429", method: http.MethodPost, status: http.StatusTooManyRequests, want: 4},
+		{name: "POST with idempotency key", method: http.MethodPost, idempotentKey: true, status: http.StatusServiceUnavailable, want: 4},
+		{name: "POST when allowed", method: http.MethodPost, status: http.StatusServiceUnavailable, retryAll: true, want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := fastRetryConfig()
+			config.RetryNonIdempotent = tt.retryAll
+
+			var attempts int
+			m := middleware.NewRetryMiddleware(failingDoer(&attempts, tt.status, tt.err), config)
+
+			req, _ := http.NewRequest(tt.method, "http://localhost/v1/spaces", strings.NewReader(`{"name":"x"}`))
+			if tt.idempotentKey {
+				req.Header.Set("Idempotency-Key", "create-x")
+			}
+			m.Do(req)
+
+			if attempts != tt.want {
+				t.Errorf("Attempt count mismatch: got %d, want %d", attempts, tt.want)
+			}
+		})
+	}
+}
+
+// TestRetryBudget tests that retries are limited across requests
+func TestRetryBudget(t *testing.T) {
+	config := fastRetryConfig()
+	config.Budget = &middleware.RetryBudget{Ratio: 0.5, MaxRetries: 2}
+
+	var attempts int
+	m := middleware.NewRetryMiddleware(failingDoer(&attempts, http.StatusServiceUnavailable, nil), config)
+
+	// The budget is full: 2 retries. The next requests earn half a retry each.
+	for i, want := range []int{3, 1, 2} {
+		attempts = 0
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/v1/spaces", nil)
+		m.Do(req)
+		if attempts != want {
+			t.Errorf("Request %d: attempt count mismatch: got %d, want %d", i, attempts, want)
+		}
+	}
+}
+
+// TestRetryOnRetry tests the retry hook and the jitter strategies
+func TestRetryOnRetry(t *testing.T) {
+	for _, jitter := range []middleware.JitterStrategy{middleware.JitterNone, middleware.JitterFull, middleware.JitterDecorrelated} {
+		t.Run(string(jitter), func(t *testing.T) {
+			config := fastRetryConfig()
+			config.Jitter = jitter
+
+			var events []middleware.RetryEvent
+			config.OnRetry = func(event middleware.RetryEvent) {
+				events = append(events, event)
+			}
+
+			var attempts int
+			m := middleware.NewRetryMiddleware(failingDoer(&attempts, http.StatusBadGateway, nil), config)
+			req, _ := http.NewRequest(http.MethodGet, "http://localhost/v1/spaces", nil)
+			m.Do(req)
+
+			if len(events) != 3 {
+				t.Fatalf("Expected 3 retry events, got %d", len(events))
+			}
+			for i, event := range events {
+				if event.Attempt != i+1 {
+					t.Errorf("Event %d: expected attempt %d, got %d", i, i+1, event.Attempt)
+				}
+				if event.Response == nil || event.Response.StatusCode != http.StatusBadGateway {
+					t.Errorf("Event %d: expected the failed response as cause", i)
+				}
+				if event.Delay < 0 || event.Delay > config.MaxRetryDelay {
+					t.Errorf("Event %d: delay %v out of bounds", i, event.Delay)
+				}
+				if jitter == middleware.JitterDecorrelated && event.Delay < config.RetryDelay {
+					t.Errorf("Event %d: delay %v below the base delay", i, event.Delay)
+				}
+			}
+			if jitter == middleware.JitterNone && events[2].Delay != 4*time.Millisecond {
+				t.Errorf("Expected exponential backoff without jitter, got %v", events[2].Delay)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/schema"
+	"github.com/rubiojr/anytype-go/tests/mocks"
+)
+
+// newSchemaTestSpace returns a mock space with IDs set on its types and properties
+func newSchemaTestSpace() *mocks.MockSpaceService {
+	space := mocks.NewMockSpaceService()
+	space.MockPropertyClient.ListFunc = func(ctx context.Context) ([]anytype.Property, error) {
+		return []anytype.Property{
+			{ID: "mock-property-id", Key: "mock_property_key", Name: "Mock Property", Format: "select"},
+			{ID: "mock-number-id", Key: "mock_number_property", Name: "Mock Number Property", Format: "number"},
+		}, nil
+	}
+	space.MockTypeService.ListFunc = func(ctx context.Context) ([]anytype.Type, error) {
+		return []anytype.Type{
+			{ID: "page-id", Key: "page", Name: "Page", Layout: "basic", PropertyDefinitions: []anytype.PropertyDefinition{
+				{Key: "mock_property_key", Name: "Mock Property", Format: "select"},
+			}},
+			{ID: "collection-id", Key: "collection", Name: "Collection", Layout: "collection"},
+		}, nil
+	}
+	return space
+}
+
+// TestSchemaPlanAndApply tests planning and applying a schema spec
+func TestSchemaPlanAndApply(t *testing.T) {
+	tc := setupTestClient(t)
+	defer cleanupTestClient(tc)
+
+	space := newSchemaTestSpace()
+
+	spec := schema.Spec{
+		Properties: []schema.PropertySpec{
+			{
+				Key:    "mock_property_key",
+				Name:   "Mock Property",
+				Format: "select",
+				Tags: []schema.TagSpec{
+					{Name: "To do", Color: anytype.ColorGrey},
+					{Name: "Done", Color: anytype.ColorRed},
+					{Name: "Blocked"},
+				},
+			},
+			{
+				Key:    "priority",
+				Name:   "Priority",
+				Format: "select",
+				Tags:   []schema.TagSpec{{Name: "High", Color: anytype.ColorRed}},
+			},
+		},
+		Types: []schema.TypeSpec{
+			{Key: "page", Name: "Page", Properties: []string{"priority"}},
+			{Key: "book", Name: "Book", Properties: []string{"priority", "mock_number_property"}},
+		},
+		DeleteTypes:      []string{"collection"},
+		DeleteProperties: []string{"missing-property"},
+	}
+
+	steps, err := schema.Plan(tc.Ctx, space, spec)
+	if err != nil {
+		t.Fatalf("Failed to plan schema: %v", err)
+	}
+
+	want := []string{
+		`update tag "Done" of property "mock_property_key"`,
+		`create tag "Blocked" of property "mock_property_key"`,
+		`create property "priority"`,
+		`create tag "High" of property "priority"`,
+		`update type "page"`,
+		`create type "book"`,
+		`delete type "collection"`,
+	}
+
+	if len(steps) != len(want) {
+		t.Fatalf("Step count mismatch: got %v, want %v", steps, want)
+	}
+
+	for i, step := range steps {
+		if step.String() != want[i] {
+			t.Errorf("Step %d mismatch: got %s, want %s", i, step, want[i])
+		}
+	}
+
+	if steps[1].CreateTag.Color != anytype.ColorGrey {
+		t.Errorf("Default tag color mismatch: got %s, want %s", steps[1].CreateTag.Color, anytype.ColorGrey)
+	}
+
+	if got := len(steps[5].CreateType.Properties); got != 2 {
+		t.Errorf("Type property count mismatch: got %d, want 2", got)
+	}
+	if got := steps[5].CreateType.PluralName; got != "Book" {
+		t.Errorf("Expected the plural name to default to the name, got %q", got)
+	}
+
+	// Updates set the linked properties, so existing links must be kept
+	var links []string
+	for _, definition := range steps[4].UpdateType.Properties {
+		links = append(links, definition.Key)
+	}
+	if strings.Join(links, ",") != "mock_property_key,priority" {
+		t.Errorf("Expected the existing link to be kept, got %v", links)
+	}
+
+	if err := schema.Apply(tc.Ctx, space, steps); err != nil {
+		t.Fatalf("Failed to apply schema: %v", err)
+	}
+
+	// Tags of the new property must be created on the ID returned by Create
+	if _, ok := space.MockProperties["new-mock-property-id"]; !ok {
+		t.Error("Expected the tag of the created property to use its new ID")
+	}
+}
+
+// TestSchemaPlanErrors tests that invalid specs and incompatible changes are rejected
+func TestSchemaPlanErrors(t *testing.T) {
+	tc := setupTestClient(t)
+	defer cleanupTestClient(tc)
+
+	space := newSchemaTestSpace()
+
+	tests := []struct {
+		name string
+		spec schema.Spec
+		want string
+	}{
+		{
+			name: "format change",
+			spec: schema.Spec{Properties: []schema.PropertySpec{{Key: "mock_number_property", Name: "Number", Format: "text"}}},
+			want: "has format",
+		},
+		{
+			name: "unknown property link",
+			spec: schema.Spec{Types: []schema.TypeSpec{{Key: "book", Name: "Book", Properties: []string{"missing"}}}},
+			want: "unknown property",
+		},
+		{
+			name: "invalid color",
+			spec: schema.Spec{Properties: []schema.PropertySpec{{Key: "status", Name: "Status", Format: "select", Tags: []schema.TagSpec{{Name: "New", Color: "magenta"}}}}},
+			want: "invalid color",
+		},
+		{
+			name: "tags on text property",
+			spec: schema.Spec{Properties: []schema.PropertySpec{{Key: "notes", Name: "Notes", Format: "text", Tags: []schema.TagSpec{{Name: "New"}}}}},
+			want: "only select and multi_select",
+		},
+		{
+			name: "invalid format",
+			spec: schema.Spec{Properties: []schema.PropertySpec{{Key: "notes", Name: "Notes", Format: "string"}}},
+			want: "invalid format",
+		},
+		{
+			name: "property key not snake_case",
+			spec: schema.Spec{Properties: []schema.PropertySpec{{Key: "due-date", Name: "Due date", Format: "date"}}},
+			want: `property key "due-date" is not snake_case`,
+		},
+		{
+			name: "type key not snake_case",
+			spec: schema.Spec{Types: []schema.TypeSpec{{Key: "BookReview", Name: "Book review"}}},
+			want: `type key "BookReview" is not snake_case`,
+		},
+		{
+			name: "declared and deleted",
+			spec: schema.Spec{Types: []schema.TypeSpec{{Key: "book", Name: "Book"}}, DeleteTypes: []string{"book"}},
+			want: "both declared and deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := schema.Plan(tc.Ctx, space, tt.spec)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// TestSchemaLoad tests decoding a spec from JSON
+func TestSchemaLoad(t *testing.T) {
+	spec, err := schema.Load(strings.NewReader(`{
+		"properties": [{"key": "status", "name": "Status", "format": "select", "tags": [{"name": "Open", "color": "blue"}]}],
+		"types": [{"key": "task", "name": "Task", "properties": ["status"]}]
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to load spec: %v", err)
+	}
+
+	if len(spec.Properties) != 1 || spec.Properties[0].Tags[0].Color != anytype.ColorBlue {
+		t.Errorf("Unexpected properties: %+v", spec.Properties)
+	}
+
+	if len(spec.Types) != 1 || spec.Types[0].Properties[0] != "status" {
+		t.Errorf("Unexpected types: %+v", spec.Types)
+	}
+}
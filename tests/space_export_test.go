@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rubiojr/anytype-go/export"
+)
+
+// TestExportSpace tests exporting a space to a directory, then updating the
+// export incrementally
+func TestExportSpace(t *testing.T) {
+	var mu sync.Mutex
+	objects := map[string]map[string]any{
+		"note-id": {
+			"id":       "note-id",
+			"name":     "Weekly Notes",
+			"type":     map[string]any{"key": "page"},
+			"markdown": "See [the task](anytype://object?objectId=task-id&spaceId=mock-space-id)",
+			"properties": []map[string]any{
+				{"key": "last_modified_date", "format": "date", "date": "2025-01-01T00:00:00Z"},
+			},
+		},
+		"task-id": {
+			"id":       "task-id",
+			"name":     "Fix bug",
+			"type":     map[string]any{"key": "task"},
+			"markdown": "Back to [notes](note-id)",
+			"properties": []map[string]any{
+				{"key": "last_modified_date", "format": "date", "date": "2025-01-01T00:00:00Z"},
+			},
+		},
+	}
+
+	list := func(data any) map[string]any {
+		return map[string]any{"data": data, "pagination": map[string]any{"has_more": false}}
+	}
+
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch path := strings.TrimPrefix(r.URL.Path, "/v1/spaces/mock-space-id"); {
+		case path == "/objects":
+			var data []map[string]any
+			for _, id := range []string{"note-id", "task-id", "new-task-id"} {
+				if obj, ok := objects[id]; ok {
+					data = append(data, obj)
+				}
+			}
+			json.NewEncoder(w).Encode(list(data))
+		case strings.HasPrefix(path, "/objects/"):
+			json.NewEncoder(w).Encode(map[string]any{"object": objects[strings.TrimPrefix(path, "/objects/")]})
+		case path == "/types":
+			json.NewEncoder(w).Encode(list([]map[string]any{{"id": "page-type-id", "key": "page", "name": "Page"}}))
+		case path == "/properties":
+			json.NewEncoder(w).Encode(list([]map[string]any{{"id": "status-id", "key": "status", "name": "Status", "format": "select"}}))
+		case path == "/properties/status-id/tags":
+			json.NewEncoder(w).Encode(list([]map[string]any{{"id": "done-id", "name": "Done", "color": "lime"}}))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer cleanupTestClient(tc)
+
+	dir := t.TempDir()
+	space := tc.Client.Space(tc.SpaceID)
+
+	readFile := func(name string) string {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Failed to read exported file: %v", err)
+		}
+		return string(data)
+	}
+
+	result, err := export.Space(tc.Ctx, space, dir, export.Options{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("Failed to export space: %v", err)
+	}
+	if result.Exported != 2 {
+		t.Errorf("Expected 2 exported objects, got %d", result.Exported)
+	}
+
+	note := readFile("page/weekly-notes.md")
+	if !strings.HasPrefix(note, "---\nid: \"note-id\"\n") {
+		t.Errorf("Expected a front matter, got:\n%s", note)
+	}
+	if !strings.Contains(note, "[the task](../task/fix-bug.md)") {
+		t.Errorf("Expected the link to the task to be rewritten, got:\n%s", note)
+	}
+	if task := readFile("task/fix-bug.md"); !strings.Contains(task, "[notes](../page/weekly-notes.md)") {
+		t.Errorf("Expected the link to the note to be rewritten, got:\n%s", task)
+	}
+	if tags := readFile("tags.json"); !strings.Contains(tags, `"Done"`) {
+		t.Errorf("Expected the tags of the status property, got:\n%s", tags)
+	}
+	readFile("types.json")
+	readFile("properties.json")
+
+	t.Run("incremental", func(t *testing.T) {
+		result, err := export.Space(tc.Ctx, space, dir, export.Options{Incremental: true})
+		if err != nil {
+			t.Fatalf("Failed to export space: %v", err)
+		}
+		if result.Exported != 0 || result.Skipped != 2 {
+			t.Errorf("Expected all objects to be skipped, got %+v", result)
+		}
+
+		mu.Lock()
+		delete(objects, "note-id")
+		objects["task-id"]["name"] = "Fix the bug"
+		objects["task-id"]["properties"] = []map[string]any{
+			{"key": "last_modified_date", "format": "date", "date": "2025-02-01T00:00:00Z"},
+		}
+		mu.Unlock()
+
+		result, err = export.Space(tc.Ctx, space, dir, export.Options{Incremental: true})
+		if err != nil {
+			t.Fatalf("Failed to export space: %v", err)
+		}
+		if result.Exported != 1 || result.Skipped != 0 || result.Removed != 1 {
+			t.Errorf("Expected 1 exported and 1 removed object, got %+v", result)
+		}
+
+		// Renamed objects keep their file
+		if task := readFile("task/fix-bug.md"); !strings.Contains(task, `name: "Fix the bug"`) {
+			t.Errorf("Expected the renamed task in its original file, got:\n%s", task)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "page/weekly-notes.md")); !os.IsNotExist(err) {
+			t.Errorf("Expected the file of the deleted note to be removed, got %v", err)
+		}
+	})
+
+	t.Run("recreated", func(t *testing.T) {
+		// Replace the task with a new object of the same type and name
+		mu.Lock()
+		delete(objects, "task-id")
+		objects["new-task-id"] = map[string]any{
+			"id":   "new-task-id",
+			"name": "Fix bug",
+			"type": map[string]any{"key": "task"},
+			"properties": []map[string]any{
+				{"key": "last_modified_date", "format": "date", "date": "2025-03-01T00:00:00Z"},
+			},
+		}
+		mu.Unlock()
+
+		// A file removed by hand is not counted
+		if err := os.Remove(filepath.Join(dir, "task/fix-bug.md")); err != nil {
+			t.Fatalf("Failed to remove file: %v", err)
+		}
+
+		result, err := export.Space(tc.Ctx, space, dir, export.Options{Incremental: true})
+		if err != nil {
+			t.Fatalf("Failed to export space: %v", err)
+		}
+		if result.Exported != 1 || result.Removed != 0 {
+			t.Errorf("Expected 1 exported and no removed object, got %+v", result)
+		}
+
+		var manifest struct {
+			Objects map[string]struct {
+				Path string `json:"path"`
+			} `json:"objects"`
+		}
+		if err := json.Unmarshal([]byte(readFile("manifest.json")), &manifest); err != nil {
+			t.Fatalf("Failed to decode manifest: %v", err)
+		}
+		path := manifest.Objects["new-task-id"].Path
+		if path == "task/fix-bug.md" {
+			t.Errorf("Expected the new object not to reuse the path of the deleted one")
+		}
+		if task := readFile(path); !strings.Contains(task, `id: "new-task-id"`) {
+			t.Errorf("Expected the new object in %s, got:\n%s", path, task)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// TestTags tests tag-related operations on a select property
+func TestTags(t *testing.T) {
+	tc := setupTestClient(t)
+	defer cleanupTestClient(tc)
+
+	spaceID := findOrCreateTestSpace(t, tc)
+	property := tc.Client.Space(spaceID).Property("mock-property-id")
+
+	// List tags
+	tags, err := property.Tags().List(tc.Ctx)
+	if err != nil {
+		t.Fatalf("Failed to list tags: %v", err)
+	}
+
+	if len(tags) == 0 {
+		t.Fatal("Expected to find at least one tag")
+	}
+
+	// Create a tag
+	createReq := anytype.CreateTagRequest{
+		Name:  "In progress",
+		Color: anytype.ColorYellow,
+	}
+
+	tagResp, err := property.Tags().Create(tc.Ctx, createReq)
+	if err != nil {
+		t.Fatalf("Failed to create tag: %v", err)
+	}
+
+	if tagResp.Tag.Name != createReq.Name {
+		t.Errorf("Tag name mismatch: got %s, want %s", tagResp.Tag.Name, createReq.Name)
+	}
+
+	if tagResp.Tag.Color != createReq.Color {
+		t.Errorf("Tag color mismatch: got %s, want %s", tagResp.Tag.Color, createReq.Color)
+	}
+
+	// Update the tag
+	tagID := tagResp.Tag.ID
+	updated, err := property.Tag(tagID).Update(tc.Ctx, anytype.UpdateTagRequest{
+		Name:  "Doing",
+		Color: anytype.ColorOrange,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update tag: %v", err)
+	}
+
+	if updated.Tag.ID != tagID {
+		t.Errorf("Tag ID mismatch: got %s, want %s", updated.Tag.ID, tagID)
+	}
+
+	// Delete the tag
+	if _, err := property.Tag(tagID).Delete(tc.Ctx); err != nil {
+		t.Fatalf("Failed to delete tag: %v", err)
+	}
+}
+
+// TestColors tests validation of the color enum
+func TestColors(t *testing.T) {
+	for _, color := range anytype.Colors {
+		if !color.IsValid() {
+			t.Errorf("Expected color %q to be valid", color)
+		}
+	}
+
+	if anytype.Color("magenta").IsValid() {
+		t.Error("Expected color \"magenta\" to be invalid")
+	}
+}
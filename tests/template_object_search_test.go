@@ -118,7 +118,7 @@ func TestObjects(t *testing.T) {
 	}
 
 	// Try to export the object
-	exportResp, err := tc.Client.Space(spaceID).Object(objectID).Export(tc.Ctx, "markdown")
+	exportResp, err := tc.Client.Space(spaceID).Object(objectID).Export(tc.Ctx, anytype.ExportFormatMarkdown)
 	if err != nil {
 		t.Logf("Export not available: %v", err)
 	} else {
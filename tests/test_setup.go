@@ -2,10 +2,13 @@ package tests
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/rubiojr/anytype-go"
+	_ "github.com/rubiojr/anytype-go/client" // Register client implementation
 	"github.com/rubiojr/anytype-go/tests/mocks"
 )
 
@@ -38,6 +41,29 @@ func setupTestClient(t *testing.T) *TestClient {
 	}
 }
 
+// setupHTTPTestClient creates a real client talking to a local test server
+// that serves requests with the given handler
+func setupHTTPTestClient(t *testing.T, handler http.Handler, opts ...anytype.ClientOption) *TestClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+
+	opts = append([]anytype.ClientOption{
+		anytype.WithBaseURL(server.URL),
+		anytype.WithAppKey("test-app-key"),
+	}, opts...)
+
+	return &TestClient{
+		Client:  anytype.NewClient(opts...),
+		SpaceID: "mock-space-id",
+		Ctx:     ctx,
+		Cancel:  cancel,
+	}
+}
+
 // skipIfNotAuthenticated is no longer needed with mocks,
 // but kept (as a no-op) for backward compatibility
 func skipIfNotAuthenticated(t *testing.T, client anytype.Client) {
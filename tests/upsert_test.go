@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// TestObjectUpsert tests creating, updating and conflicting upserts
+func TestObjectUpsert(t *testing.T) {
+	var requests []string
+	tc := setupHTTPTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/v1/spaces/mock-space-id")
+		requests = append(requests, r.Method+" "+path)
+
+		externalID := func(value string) []map[string]any {
+			return []map[string]any{{"key": "external_id", "format": "text", "text": value}}
+		}
+
+		switch {
+		case path == "/search":
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]any{
+					{"id": "ticket-1", "name": "Login fails", "properties": externalID("JIRA-1")},
+					{"id": "ticket-2", "name": "Duplicate", "properties": externalID("JIRA-2")},
+					{"id": "ticket-3", "name": "Duplicate", "properties": externalID("JIRA-2")},
+				},
+				"pagination": map[string]any{"has_more": false},
+			})
+		case r.Method == http.MethodPost && path == "/objects":
+			json.NewEncoder(w).Encode(map[string]any{"object": map[string]any{"id": "ticket-new"}})
+		case strings.HasPrefix(path, "/objects/"):
+			json.NewEncoder(w).Encode(map[string]any{"object": map[string]any{"id": strings.TrimPrefix(path, "/objects/")}})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer cleanupTestClient(tc)
+
+	objects := tc.Client.Space(tc.SpaceID).Objects()
+	byExternalID := anytype.UpsertByProperty("external_id")
+
+	ticket := func(name, externalID string) anytype.CreateObjectRequest {
+		return anytype.CreateObjectRequest{
+			TypeKey:    "ticket",
+			Name:       name,
+			Properties: []anytype.PropertyLinkValue{anytype.TextProperty("external_id", externalID)},
+		}
+	}
+
+	t.Run("update", func(t *testing.T) {
+		requests = nil
+		resp, err := objects.Upsert(tc.Ctx, byExternalID, ticket("Login is broken", "JIRA-1"))
+		if err != nil {
+			t.Fatalf("Failed to upsert object: %v", err)
+		}
+		if resp.Created || resp.Object.ID != "ticket-1" {
+			t.Errorf("Expected ticket-1 to be updated, got %+v", resp)
+		}
+		want := []string{"POST /search", "PATCH /objects/ticket-1", "GET /objects/ticket-1"}
+		if strings.Join(requests, ",") != strings.Join(want, ",") {
+			t.Errorf("Unexpected requests: %v", requests)
+		}
+	})
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := objects.Upsert(tc.Ctx, byExternalID, ticket("New bug", "JIRA-9"))
+		if err != nil {
+			t.Fatalf("Failed to upsert object: %v", err)
+		}
+		if !resp.Created || resp.Object.ID != "ticket-new" {
+			t.Errorf("Expected a new object, got %+v", resp)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		for _, key := range []anytype.UpsertKey{byExternalID, anytype.UpsertByName()} {
+			_, err := objects.Upsert(tc.Ctx, key, ticket("Duplicate", "JIRA-2"))
+			if !errors.Is(err, anytype.ErrConflict) {
+				t.Fatalf("Expected ErrConflict, got %v", err)
+			}
+
+			var conflict *anytype.UpsertConflictError
+			if !errors.As(err, &conflict) || len(conflict.ObjectIDs) != 2 {
+				t.Errorf("Expected the conflicting object IDs, got %v", err)
+			}
+		}
+	})
+
+	t.Run("update with body", func(t *testing.T) {
+		requests = nil
+		request := ticket("Login is broken", "JIRA-1")
+		request.Body = "Steps to reproduce"
+		_, err := objects.Upsert(tc.Ctx, byExternalID, request)
+		if !errors.Is(err, anytype.ErrUnsupported) {
+			t.Fatalf("Expected ErrUnsupported, got %v", err)
+		}
+		if strings.Join(requests, ",") != "POST /search" {
+			t.Errorf("Expected the object to be left unchanged, got requests %v", requests)
+		}
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := objects.Upsert(tc.Ctx, anytype.UpsertByProperty("external_id"), anytype.CreateObjectRequest{TypeKey: "ticket", Name: "No ID"})
+		if !errors.Is(err, anytype.ErrValidation) {
+			t.Errorf("Expected ErrValidation, got %v", err)
+		}
+	})
+}
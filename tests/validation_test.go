@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+	"github.com/rubiojr/anytype-go/middleware"
+)
+
+// TestRequestValidate tests the field-level validation of request types
+func TestRequestValidate(t *testing.T) {
+	emptyName := ""
+
+	tests := []struct {
+		name    string
+		request middleware.Validator
+		fields  []string
+	}{
+		{
+			name: "valid object",
+			request: anytype.CreateObjectRequest{
+				TypeKey:    "page",
+				Icon:       &anytype.Icon{Format: anytype.IconFormatIcon, Name: "document", Color: "blue"},
+				Properties: []anytype.PropertyLinkValue{anytype.TextProperty("summary", "text")},
+			},
+		},
+		{
+			name: "invalid object",
+			request: anytype.CreateObjectRequest{
+				Icon:       &anytype.Icon{Format: anytype.IconFormatEmoji, Color: "magenta"},
+				Properties: []anytype.PropertyLinkValue{{Key: "summary"}},
+			},
+			fields: []string{"type_key", "icon.emoji", "icon.color", "properties[0].value"},
+		},
+		{
+			name:    "icon without format",
+			request: anytype.UpdateObjectRequest{Icon: &anytype.Icon{Emoji: "📝"}},
+			fields:  []string{"icon.format"},
+		},
+		{
+			name:    "space without name",
+			request: anytype.CreateSpaceRequest{Description: "Notes"},
+			fields:  []string{"name"},
+		},
+		{
+			name:    "space renamed to nothing",
+			request: anytype.UpdateSpaceRequest{Name: &emptyName},
+			fields:  []string{"name"},
+		},
+		{
+			name: "valid type",
+			request: anytype.CreateTypeRequest{
+				Name: "Book", PluralName: "Books", Layout: "basic",
+				Properties: []anytype.PropertyDefinition{{Key: "author", Name: "Author", Format: "text"}},
+			},
+		},
+		{
+			name: "invalid type",
+			request: anytype.CreateTypeRequest{
+				Name: "Book", Layout: "grid",
+				Properties: []anytype.PropertyDefinition{{Key: "author", Name: "Author", Format: "string"}},
+			},
+			fields: []string{"plural_name", "layout", "properties[0].format"},
+		},
+		{
+			name:    "type update with invalid layout",
+			request: anytype.UpdateTypeRequest{Layout: "board"},
+			fields:  []string{"layout"},
+		},
+		{
+			name:    "property without format",
+			request: anytype.CreatePropertyRequest{Name: "Author"},
+			fields:  []string{"format"},
+		},
+		{
+			name:    "property update without name",
+			request: anytype.UpdatePropertyRequest{Key: "author"},
+			fields:  []string{"name"},
+		},
+		{
+			name:    "tag without color",
+			request: anytype.CreateTagRequest{Name: "Urgent"},
+			fields:  []string{"color"},
+		},
+		{
+			name:    "tag update with invalid color",
+			request: anytype.UpdateTagRequest{Color: "black"},
+			fields:  []string{"color"},
+		},
+		{
+			name:    "search with invalid sort",
+			request: anytype.SearchRequest{Sort: &anytype.SortOptions{Property: "size", Direction: "up"}},
+			fields:  []string{"sort.property", "sort.direction"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Expected the request to be valid, got %v", err)
+				}
+				return
+			}
+
+			var validationErr *anytype.RequestValidationError
+			if !errors.Is(err, anytype.ErrValidation) || !errors.As(err, &validationErr) {
+				t.Fatalf("Expected a RequestValidationError, got %v", err)
+			}
+
+			var fields []string
+			for _, field := range validationErr.Fields {
+				fields = append(fields, field.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.fields, ",") {
+				t.Errorf("Expected invalid fields %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+// TestClientValidation tests that invalid requests fail before being sent
+func TestClientValidation(t *testing.T) {
+	newHandler := func(requests *int32) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(requests, 1)
+			w.Write([]byte(`{"tag":{"id":"tag-id","name":"Urgent"}}`))
+		})
+	}
+	request := anytype.CreateTagRequest{Name: "Urgent", Color: "black"}
+
+	t.Run("validated", func(t *testing.T) {
+		var requests int32
+		tc := setupHTTPTestClient(t, newHandler(&requests))
+		defer cleanupTestClient(tc)
+
+		_, err := tc.Client.Space(tc.SpaceID).Property("property-id").Tags().Create(tc.Ctx, request)
+		if !errors.Is(err, anytype.ErrValidation) {
+			t.Errorf("Expected ErrValidation, got %v", err)
+		}
+		if got := atomic.LoadInt32(&requests); got != 0 {
+			t.Errorf("Expected no request to be sent, got %d", got)
+		}
+	})
+
+	t.Run("without validation", func(t *testing.T) {
+		var requests int32
+		tc := setupHTTPTestClient(t, newHandler(&requests), anytype.WithoutValidation())
+		defer cleanupTestClient(tc)
+
+		if _, err := tc.Client.Space(tc.SpaceID).Property("property-id").Tags().Create(tc.Ctx, request); err != nil {
+			t.Fatalf("Failed to create tag: %v", err)
+		}
+		if got := atomic.LoadInt32(&requests); got != 1 {
+			t.Errorf("Expected the request to be sent, got %d requests", got)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rubiojr/anytype-go"
+)
+
+// viewObjects are the objects views are applied to
+const viewObjects = `[
+	{"id": "a", "name": "alpha", "properties": [
+		{"key": "priority", "format": "number", "number": 2},
+		{"key": "status", "format": "select", "select": {"id": "tag-open", "name": "Open"}},
+		{"key": "due", "format": "date", "date": "2025-03-01T09:30:00+02:00"}
+	]},
+	{"id": "b", "name": "Bravo", "properties": [
+		{"key": "priority", "format": "number", "number": 1},
+		{"key": "status", "format": "select", "select": {"id": "tag-done", "name": "Done"}},
+		{"key": "due", "format": "date", "date": "2025-01-01T00:00:00Z"}
+	]},
+	{"id": "c", "name": "charlie", "properties": [
+		{"key": "priority", "format": "number", "number": 2},
+		{"key": "status", "format": "select", "select": {"id": "tag-open", "name": "Open"}},
+		{"key": "done", "format": "checkbox", "checkbox": true}
+	]},
+	{"id": "d", "name": "delta", "properties": [
+		{"key": "status", "format": "select", "select": {"id": "tag-blocked", "name": "Blocked"}}
+	]}
+]`
+
+// TestApplyView tests reproducing list views over objects
+func TestApplyView(t *testing.T) {
+	var objects []anytype.Object
+	if err := json.Unmarshal([]byte(viewObjects), &objects); err != nil {
+		t.Fatalf("Failed to decode objects: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		view anytype.ListView
+		want string
+	}{
+		{
+			name: "no filters or sorts",
+			view: anytype.ListView{},
+			want: "a,b,c,d",
+		},
+		{
+			name: "not equal",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "status", Format: "select", Condition: "not_equal", Value: "tag-done"},
+			}},
+			want: "a,c,d",
+		},
+		{
+			name: "all filters apply",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "status", Format: "select", Condition: "in", Value: "Open,Done"},
+				{PropertyKey: "due", Format: "date", Condition: "less", Value: "2025-02-01"},
+			}},
+			want: "b",
+		},
+		{
+			name: "date only values compare calendar days",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "due", Format: "date", Condition: "equal", Value: "2025-03-01"},
+			}},
+			want: "a",
+		},
+		{
+			name: "date only bounds include the day",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "due", Format: "date", Condition: "less_or_equal", Value: "2025-03-01"},
+			}},
+			want: "a,b",
+		},
+		{
+			name: "dates with a time compare exactly",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "due", Format: "date", Condition: "equal", Value: "2025-03-01T00:00:00Z"},
+			}},
+			want: "",
+		},
+		{
+			name: "empty checkbox",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "done", Format: "checkbox", Condition: "empty"},
+			}},
+			want: "a,b,d",
+		},
+		{
+			name: "unknown condition",
+			view: anytype.ListView{Filters: []anytype.ListFilter{
+				{PropertyKey: "status", Condition: "nearby"},
+			}},
+			want: "",
+		},
+		{
+			name: "sort by name ignores case",
+			view: anytype.ListView{Sorts: []anytype.ListSort{
+				{PropertyKey: "name", Format: "text", SortType: "desc"},
+			}},
+			want: "d,c,b,a",
+		},
+		{
+			name: "empty values sort last",
+			view: anytype.ListView{Sorts: []anytype.ListSort{
+				{PropertyKey: "due", Format: "date", SortType: "asc"},
+			}},
+			want: "b,a,c,d",
+		},
+		{
+			name: "sorts break ties in order",
+			view: anytype.ListView{Sorts: []anytype.ListSort{
+				{PropertyKey: "priority", Format: "number", SortType: "desc"},
+				{PropertyKey: "status", Format: "select", SortType: "asc"},
+				{PropertyKey: "name", Format: "text", SortType: "desc"},
+			}},
+			want: "c,a,b,d",
+		},
+		{
+			name: "custom sort keeps order",
+			view: anytype.ListView{Sorts: []anytype.ListSort{
+				{PropertyKey: "priority", Format: "number", SortType: "custom"},
+			}},
+			want: "a,b,c,d",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			for _, obj := range anytype.ApplyView(tt.view, objects) {
+				ids = append(ids, obj.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package anytype
 
 import (
 	"context"
+	"iter"
+
+	"github.com/rubiojr/anytype-go/options"
 )
 
 // TypeClient provides operations on object types within a space
@@ -9,6 +12,9 @@ type TypeClient interface {
 	// List returns all available object types in the space
 	List(ctx context.Context) ([]Type, error)
 
+	// All returns an iterator over all object types in the space, fetching pages lazily
+	All(ctx context.Context, opts ...options.ListOption) iter.Seq2[Type, error]
+
 	// Get retrieves details of a specific type by key
 	Get(ctx context.Context, typeKey string) (*Type, error)
 
@@ -27,6 +33,12 @@ type TypeContext interface {
 	// Get retrieves details of this specific type
 	Get(ctx context.Context) (*TypeResponse, error)
 
+	// Update updates this type
+	Update(ctx context.Context, request UpdateTypeRequest) (*TypeResponse, error)
+
+	// Delete deletes this type
+	Delete(ctx context.Context) (*TypeResponse, error)
+
 	// Templates returns a TemplateClient for this type
 	Templates() TemplateClient
 
@@ -39,6 +51,7 @@ type Type struct {
 	ID                string `json:"id"`
 	Key               string
 	Name              string
+	PluralName        string `json:"plural_name"`
 	Description       string
 	Icon              *Icon
 	Layout            string
@@ -47,7 +60,7 @@ type Type struct {
 	IsHidden          bool   `json:"is_hidden"`
 
 	// Available property definitions for this type
-	PropertyDefinitions []PropertyDefinition `json:"property_definitions"`
+	PropertyDefinitions []PropertyDefinition `json:"properties"`
 }
 
 // PropertyDefinition defines a property that can be used with a type
@@ -64,6 +77,29 @@ type PropertyOption struct {
 	Color string
 }
 
+// TypeLayout represents the layout of the objects of a type
+type TypeLayout string
+
+const (
+	TypeLayoutBasic   TypeLayout = "basic"
+	TypeLayoutProfile TypeLayout = "profile"
+	TypeLayoutAction  TypeLayout = "action"
+	TypeLayoutNote    TypeLayout = "note"
+)
+
+// TypeLayouts lists all type layouts supported by the API
+var TypeLayouts = []TypeLayout{TypeLayoutBasic, TypeLayoutProfile, TypeLayoutAction, TypeLayoutNote}
+
+// IsValid reports whether the layout is one of the layouts supported by the API
+func (l TypeLayout) IsValid() bool {
+	for _, layout := range TypeLayouts {
+		if l == layout {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateTypeRequest represents the request payload for creating a new type
 type CreateTypeRequest struct {
 	Key        string               `json:"key,omitempty"`
@@ -74,6 +110,17 @@ type CreateTypeRequest struct {
 	Properties []PropertyDefinition `json:"properties,omitempty"`
 }
 
+// UpdateTypeRequest represents the request payload for updating a type.
+// Empty fields are left unchanged.
+type UpdateTypeRequest struct {
+	Key        string               `json:"key,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	Icon       *Icon                `json:"icon,omitempty"`
+	Layout     string               `json:"layout,omitempty"`
+	PluralName string               `json:"plural_name,omitempty"`
+	Properties []PropertyDefinition `json:"properties,omitempty"`
+}
+
 // TypeResponse represents the response from a Get call on a type
 type TypeResponse struct {
 	Type Type `json:"type"`
@@ -0,0 +1,104 @@
+package anytype
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UpsertKey identifies the existing object an upsert applies to, by name or
+// by the value of a property such as an external ID
+type UpsertKey struct {
+	// PropertyKey is the key of the property identifying the object. When
+	// empty, objects are identified by name.
+	PropertyKey string
+}
+
+// UpsertByName identifies objects by name
+func UpsertByName() UpsertKey {
+	return UpsertKey{}
+}
+
+// UpsertByProperty identifies objects by the value of a property
+func UpsertByProperty(key string) UpsertKey {
+	return UpsertKey{PropertyKey: key}
+}
+
+// String returns a human readable description of the key
+func (k UpsertKey) String() string {
+	if k.PropertyKey == "" {
+		return "name"
+	}
+	return "property " + k.PropertyKey
+}
+
+// Value returns the identifying value set by a create request. It returns an
+// error wrapping ErrValidation when the request does not set it.
+func (k UpsertKey) Value(request CreateObjectRequest) (string, error) {
+	if k.PropertyKey == "" {
+		if request.Name == "" {
+			return "", fmt.Errorf("upsert by name requires a name: %w", ErrValidation)
+		}
+		return request.Name, nil
+	}
+
+	for _, property := range request.Properties {
+		if property.Key == k.PropertyKey && property.Value != nil {
+			return upsertValue(property.Value), nil
+		}
+	}
+	return "", fmt.Errorf("upsert by %s requires the request to set it: %w", k, ErrValidation)
+}
+
+// Matches reports whether an object carries the identifying value returned by Value
+func (k UpsertKey) Matches(obj Object, value string) bool {
+	if k.PropertyKey == "" {
+		return obj.Name == value
+	}
+
+	for _, property := range obj.Properties {
+		if property.Key == k.PropertyKey && property.Value != nil {
+			return upsertValue(property.Value) == value
+		}
+	}
+	return false
+}
+
+// upsertValue returns a comparable encoding of a property value. Values are
+// compared as sent in requests, so select values compare by tag ID.
+func upsertValue(value PropertyValue) string {
+	data, err := json.Marshal(value.linkValue())
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// UpsertResponse is the result of an upsert
+type UpsertResponse struct {
+	// Object is the created or updated object
+	Object *Object
+	// Created reports whether the object was created rather than updated
+	Created bool
+}
+
+// UpsertConflictError is returned by Upsert when several objects match the
+// key. It matches ErrConflict with errors.Is.
+type UpsertConflictError struct {
+	// Key is the key of the upsert
+	Key UpsertKey
+	// Value is the identifying value of the request
+	Value string
+	// ObjectIDs are the IDs of the matching objects
+	ObjectIDs []string
+}
+
+// Error implements the error interface
+func (e *UpsertConflictError) Error() string {
+	return fmt.Sprintf("upsert by %s %s: %d objects match: %s", e.Key, e.Value, len(e.ObjectIDs), strings.Join(e.ObjectIDs, ", "))
+}
+
+// Is reports whether target is ErrConflict
+func (e *UpsertConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
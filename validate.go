@@ -0,0 +1,241 @@
+package anytype
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError describes an invalid field of a request
+type FieldError struct {
+	// Field is the JSON name of the field, e.g. "icon.color" or "properties[2].key"
+	Field string
+	// Message describes the problem
+	Message string
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// RequestValidationError is returned by Validate, and by the client for
+// requests failing validation before being sent. It matches ErrValidation with
+// errors.Is.
+type RequestValidationError struct {
+	// Request is the name of the request type
+	Request string
+	// Fields are the invalid fields
+	Fields []FieldError
+}
+
+// Error implements the error interface
+func (e *RequestValidationError) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		fields[i] = field.Error()
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Request, strings.Join(fields, "; "))
+}
+
+// Is reports whether target is ErrValidation
+func (e *RequestValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// validation collects the invalid fields of a request
+type validation struct {
+	request string
+	fields  []FieldError
+}
+
+// fail records an invalid field
+func (v *validation) fail(field, format string, args ...any) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// require records a missing required field
+func (v *validation) require(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "is required")
+	}
+}
+
+// color records an invalid color, empty colors being valid
+func (v *validation) color(field string, color Color) {
+	if color != "" && !color.IsValid() {
+		v.fail(field, "invalid color %q", color)
+	}
+}
+
+// icon records the invalid fields of an icon, nil icons being valid
+func (v *validation) icon(field string, icon *Icon) {
+	if icon == nil {
+		return
+	}
+
+	switch icon.Format {
+	case IconFormatEmoji:
+		v.require(field+".emoji", icon.Emoji)
+	case IconFormatFile:
+		v.require(field+".file", icon.File)
+	case IconFormatIcon:
+		v.require(field+".name", icon.Name)
+	case "":
+		v.fail(field+".format", "is required")
+	default:
+		v.fail(field+".format", "invalid icon format %q", icon.Format)
+	}
+	v.color(field+".color", Color(icon.Color))
+}
+
+// properties records the invalid fields of property values
+func (v *validation) properties(properties []PropertyLinkValue) {
+	for i, property := range properties {
+		field := fmt.Sprintf("properties[%d]", i)
+		v.require(field+".key", property.Key)
+		if property.Value == nil {
+			v.fail(field+".value", "is required")
+		}
+	}
+}
+
+// definitions records the invalid fields of the property definitions of a type
+func (v *validation) definitions(definitions []PropertyDefinition) {
+	for i, definition := range definitions {
+		field := fmt.Sprintf("properties[%d]", i)
+		v.require(field+".name", definition.Name)
+		v.format(field+".format", definition.Format)
+	}
+}
+
+// format records a missing or invalid property format
+func (v *validation) format(field, format string) {
+	if format == "" {
+		v.fail(field, "is required")
+	} else if !PropertyFormat(format).IsValid() {
+		v.fail(field, "invalid property format %q", format)
+	}
+}
+
+// layout records an invalid type layout, empty layouts being valid
+func (v *validation) layout(field, layout string) {
+	if layout != "" && !TypeLayout(layout).IsValid() {
+		v.fail(field, "invalid layout %q", layout)
+	}
+}
+
+// err returns the validation error, or nil when all fields are valid
+func (v *validation) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &RequestValidationError{Request: v.request, Fields: v.fields}
+}
+
+// Validate checks the request for missing and invalid fields
+func (r CreateObjectRequest) Validate() error {
+	v := validation{request: "CreateObjectRequest"}
+	v.require("type_key", r.TypeKey)
+	v.icon("icon", r.Icon)
+	v.properties(r.Properties)
+	return v.err()
+}
+
+// Validate checks the request for invalid fields
+func (r UpdateObjectRequest) Validate() error {
+	v := validation{request: "UpdateObjectRequest"}
+	v.icon("icon", r.Icon)
+	v.properties(r.Properties)
+	return v.err()
+}
+
+// Validate checks the request for missing and invalid fields
+func (r CreateSpaceRequest) Validate() error {
+	v := validation{request: "CreateSpaceRequest"}
+	v.require("name", r.Name)
+	v.icon("icon", r.Icon)
+	return v.err()
+}
+
+// Validate checks the request for invalid fields
+func (r UpdateSpaceRequest) Validate() error {
+	v := validation{request: "UpdateSpaceRequest"}
+	if r.Name != nil {
+		v.require("name", *r.Name)
+	}
+	v.icon("icon", r.Icon)
+	return v.err()
+}
+
+// Validate checks the request for missing and invalid fields
+func (r CreateTypeRequest) Validate() error {
+	v := validation{request: "CreateTypeRequest"}
+	v.require("name", r.Name)
+	v.require("plural_name", r.PluralName)
+	v.require("layout", r.Layout)
+	v.layout("layout", r.Layout)
+	v.icon("icon", r.Icon)
+	v.definitions(r.Properties)
+	return v.err()
+}
+
+// Validate checks the request for invalid fields
+func (r UpdateTypeRequest) Validate() error {
+	v := validation{request: "UpdateTypeRequest"}
+	v.layout("layout", r.Layout)
+	v.icon("icon", r.Icon)
+	v.definitions(r.Properties)
+	return v.err()
+}
+
+// Validate checks the request for missing and invalid fields
+func (r CreatePropertyRequest) Validate() error {
+	v := validation{request: "CreatePropertyRequest"}
+	v.require("name", r.Name)
+	v.format("format", r.Format)
+	return v.err()
+}
+
+// Validate checks the request for missing fields
+func (r UpdatePropertyRequest) Validate() error {
+	v := validation{request: "UpdatePropertyRequest"}
+	v.require("name", r.Name)
+	return v.err()
+}
+
+// Validate checks the request for missing and invalid fields
+func (r CreateTagRequest) Validate() error {
+	v := validation{request: "CreateTagRequest"}
+	v.require("name", r.Name)
+	v.require("color", string(r.Color))
+	v.color("color", r.Color)
+	return v.err()
+}
+
+// Validate checks the request for invalid fields
+func (r UpdateTagRequest) Validate() error {
+	v := validation{request: "UpdateTagRequest"}
+	v.color("color", r.Color)
+	return v.err()
+}
+
+// Validate checks the request for invalid sort options
+func (r SearchRequest) Validate() error {
+	v := validation{request: "SearchRequest"}
+	if r.Sort != nil {
+		switch r.Sort.Property {
+		case "", SortPropertyCreatedDate, SortPropertyLastModifiedDate, SortPropertyLastOpenedDate, SortPropertyName:
+		default:
+			v.fail("sort.property", "invalid sort property %q", r.Sort.Property)
+		}
+		switch r.Sort.Direction {
+		case "", SortDirectionAsc, SortDirectionDesc:
+		default:
+			v.fail("sort.direction", "invalid sort direction %q", r.Sort.Direction)
+		}
+	}
+	for i, typeKey := range r.Types {
+		v.require(fmt.Sprintf("types[%d]", i), typeKey)
+	}
+	return v.err()
+}
@@ -0,0 +1,466 @@
+package anytype
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PropertyFormat represents the format of a property
+type PropertyFormat string
+
+const (
+	PropertyFormatText        PropertyFormat = "text"
+	PropertyFormatNumber      PropertyFormat = "number"
+	PropertyFormatSelect      PropertyFormat = "select"
+	PropertyFormatMultiSelect PropertyFormat = "multi_select"
+	PropertyFormatDate        PropertyFormat = "date"
+	PropertyFormatFiles       PropertyFormat = "files"
+	PropertyFormatCheckbox    PropertyFormat = "checkbox"
+	PropertyFormatURL         PropertyFormat = "url"
+	PropertyFormatEmail       PropertyFormat = "email"
+	PropertyFormatPhone       PropertyFormat = "phone"
+	PropertyFormatObjects     PropertyFormat = "objects"
+)
+
+// PropertyFormats lists all property formats supported by the API
+var PropertyFormats = []PropertyFormat{
+	PropertyFormatText, PropertyFormatNumber, PropertyFormatSelect, PropertyFormatMultiSelect,
+	PropertyFormatDate, PropertyFormatFiles, PropertyFormatCheckbox, PropertyFormatURL,
+	PropertyFormatEmail, PropertyFormatPhone, PropertyFormatObjects,
+}
+
+// IsValid reports whether the format is one of the formats supported by the API
+func (f PropertyFormat) IsValid() bool {
+	for _, format := range PropertyFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// PropertyValue is the value of a property in one of the formats supported by
+// the API. It is implemented by TextValue, NumberValue, SelectValue,
+// MultiSelectValue, DateValue, FilesValue, CheckboxValue, URLValue,
+// EmailValue, PhoneValue and ObjectsValue.
+type PropertyValue interface {
+	// Format returns the property format the value belongs to
+	Format() PropertyFormat
+
+	// linkValue returns the value as sent in create and update requests
+	linkValue() any
+
+	// value returns the value as returned by the API
+	value() any
+}
+
+// TextValue is the value of a text property
+type TextValue struct {
+	Text string
+}
+
+// NumberValue is the value of a number property
+type NumberValue struct {
+	Number float64
+}
+
+// SelectValue is the value of a select property. Only the tag ID is sent
+// in requests; a nil Tag clears the property.
+type SelectValue struct {
+	Tag *Tag
+}
+
+// MultiSelectValue is the value of a multi-select property. Only the tag IDs
+// are sent in requests.
+type MultiSelectValue struct {
+	Tags []Tag
+}
+
+// DateValue is the value of a date property
+type DateValue struct {
+	Date time.Time
+}
+
+// FilesValue is the value of a files property
+type FilesValue struct {
+	Files []string
+}
+
+// CheckboxValue is the value of a checkbox property
+type CheckboxValue struct {
+	Checkbox bool
+}
+
+// URLValue is the value of a url property
+type URLValue struct {
+	URL string
+}
+
+// EmailValue is the value of an email property
+type EmailValue struct {
+	Email string
+}
+
+// PhoneValue is the value of a phone property
+type PhoneValue struct {
+	Phone string
+}
+
+// ObjectsValue is the value of an objects property
+type ObjectsValue struct {
+	Objects []string
+}
+
+func (TextValue) Format() PropertyFormat        { return PropertyFormatText }
+func (NumberValue) Format() PropertyFormat      { return PropertyFormatNumber }
+func (SelectValue) Format() PropertyFormat      { return PropertyFormatSelect }
+func (MultiSelectValue) Format() PropertyFormat { return PropertyFormatMultiSelect }
+func (DateValue) Format() PropertyFormat        { return PropertyFormatDate }
+func (FilesValue) Format() PropertyFormat       { return PropertyFormatFiles }
+func (CheckboxValue) Format() PropertyFormat    { return PropertyFormatCheckbox }
+func (URLValue) Format() PropertyFormat         { return PropertyFormatURL }
+func (EmailValue) Format() PropertyFormat       { return PropertyFormatEmail }
+func (PhoneValue) Format() PropertyFormat       { return PropertyFormatPhone }
+func (ObjectsValue) Format() PropertyFormat     { return PropertyFormatObjects }
+
+func (v TextValue) value() any     { return v.Text }
+func (v NumberValue) value() any   { return v.Number }
+func (v SelectValue) value() any   { return v.Tag }
+func (v FilesValue) value() any    { return nonNil(v.Files) }
+func (v CheckboxValue) value() any { return v.Checkbox }
+func (v URLValue) value() any      { return v.URL }
+func (v EmailValue) value() any    { return v.Email }
+func (v PhoneValue) value() any    { return v.Phone }
+func (v ObjectsValue) value() any  { return nonNil(v.Objects) }
+
+func (v MultiSelectValue) value() any {
+	if v.Tags == nil {
+		return []Tag{}
+	}
+	return v.Tags
+}
+
+func (v DateValue) value() any {
+	if v.Date.IsZero() {
+		return nil
+	}
+	return v.Date.Format(time.RFC3339)
+}
+
+func (v TextValue) linkValue() any     { return v.value() }
+func (v NumberValue) linkValue() any   { return v.value() }
+func (v DateValue) linkValue() any     { return v.value() }
+func (v FilesValue) linkValue() any    { return v.value() }
+func (v CheckboxValue) linkValue() any { return v.value() }
+func (v URLValue) linkValue() any      { return v.value() }
+func (v EmailValue) linkValue() any    { return v.value() }
+func (v PhoneValue) linkValue() any    { return v.value() }
+func (v ObjectsValue) linkValue() any  { return v.value() }
+
+func (v SelectValue) linkValue() any {
+	if v.Tag == nil {
+		return nil
+	}
+	return v.Tag.ID
+}
+
+func (v MultiSelectValue) linkValue() any {
+	ids := make([]string, 0, len(v.Tags))
+	for _, tag := range v.Tags {
+		ids = append(ids, tag.ID)
+	}
+	return ids
+}
+
+// nonNil returns an empty slice instead of nil so that clearing a list
+// property is sent as [] rather than null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// PropertyWithValue is a property of an object together with its value, as
+// returned by the API. Value is nil when the format is not known to this client.
+type PropertyWithValue struct {
+	ID     string
+	Key    string
+	Name   string
+	Object string
+	Format PropertyFormat
+	Value  PropertyValue
+}
+
+// propertyHeader holds the fields shared by all property values
+type propertyHeader struct {
+	ID     string         `json:"id,omitempty"`
+	Key    string         `json:"key"`
+	Name   string         `json:"name,omitempty"`
+	Object string         `json:"object,omitempty"`
+	Format PropertyFormat `json:"format,omitempty"`
+}
+
+// MarshalJSON encodes the property with its value stored under the field
+// named after its format
+func (p PropertyWithValue) MarshalJSON() ([]byte, error) {
+	format := p.Format
+	if format == "" && p.Value != nil {
+		format = p.Value.Format()
+	}
+
+	var value any
+	if p.Value != nil {
+		value = p.Value.value()
+	}
+
+	return marshalProperty(propertyHeader{
+		ID:     p.ID,
+		Key:    p.Key,
+		Name:   p.Name,
+		Object: p.Object,
+		Format: format,
+	}, format, value)
+}
+
+// UnmarshalJSON decodes the property, using its format to pick the value type
+func (p *PropertyWithValue) UnmarshalJSON(data []byte) error {
+	var header propertyHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	value, err := decodePropertyValue(header.Format, fields[string(header.Format)], false)
+	if err != nil {
+		return fmt.Errorf("property %q: %w", header.Key, err)
+	}
+
+	*p = PropertyWithValue{
+		ID:     header.ID,
+		Key:    header.Key,
+		Name:   header.Name,
+		Object: header.Object,
+		Format: header.Format,
+		Value:  value,
+	}
+	return nil
+}
+
+// PropertyLinkValue sets the value of a property in create and update
+// requests. Use the Property helpers such as TextProperty to build one.
+type PropertyLinkValue struct {
+	Key   string
+	Value PropertyValue
+}
+
+// MarshalJSON encodes the value as {"key": ..., "<format>": ...}
+func (p PropertyLinkValue) MarshalJSON() ([]byte, error) {
+	if p.Value == nil {
+		return nil, fmt.Errorf("property %q has no value", p.Key)
+	}
+
+	format := p.Value.Format()
+	return marshalProperty(propertyHeader{Key: p.Key}, format, p.Value.linkValue())
+}
+
+// UnmarshalJSON decodes the value, inferring its format from the field present
+func (p *PropertyLinkValue) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var key string
+	if err := json.Unmarshal(fields["key"], &key); err != nil {
+		return fmt.Errorf("property value has no key: %w", err)
+	}
+
+	for name, raw := range fields {
+		if name == "key" {
+			continue
+		}
+
+		value, err := decodePropertyValue(PropertyFormat(name), raw, true)
+		if err != nil {
+			return fmt.Errorf("property %q: %w", key, err)
+		}
+		if value != nil {
+			*p = PropertyLinkValue{Key: key, Value: value}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("property %q has no value", key)
+}
+
+// marshalProperty encodes the header fields and adds the value under the
+// field named after the format
+func marshalProperty(header propertyHeader, format PropertyFormat, value any) ([]byte, error) {
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	if format != "" {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[string(format)] = raw
+	}
+
+	return json.Marshal(fields)
+}
+
+// decodePropertyValue decodes raw as a value of the given format. Link values
+// carry tag IDs for select formats instead of tag objects. Unknown formats
+// decode to nil.
+func decodePropertyValue(format PropertyFormat, raw json.RawMessage, link bool) (PropertyValue, error) {
+	if raw == nil {
+		raw = json.RawMessage("null")
+	}
+
+	var err error
+	switch format {
+	case PropertyFormatText:
+		var v TextValue
+		err = json.Unmarshal(raw, &v.Text)
+		return v, err
+	case PropertyFormatNumber:
+		var v NumberValue
+		err = json.Unmarshal(raw, &v.Number)
+		return v, err
+	case PropertyFormatCheckbox:
+		var v CheckboxValue
+		err = json.Unmarshal(raw, &v.Checkbox)
+		return v, err
+	case PropertyFormatURL:
+		var v URLValue
+		err = json.Unmarshal(raw, &v.URL)
+		return v, err
+	case PropertyFormatEmail:
+		var v EmailValue
+		err = json.Unmarshal(raw, &v.Email)
+		return v, err
+	case PropertyFormatPhone:
+		var v PhoneValue
+		err = json.Unmarshal(raw, &v.Phone)
+		return v, err
+	case PropertyFormatFiles:
+		var v FilesValue
+		err = json.Unmarshal(raw, &v.Files)
+		return v, err
+	case PropertyFormatObjects:
+		var v ObjectsValue
+		err = json.Unmarshal(raw, &v.Objects)
+		return v, err
+	case PropertyFormatDate:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil || s == "" {
+			return DateValue{}, err
+		}
+		date, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q: %w", s, err)
+		}
+		return DateValue{Date: date}, nil
+	case PropertyFormatSelect:
+		if link {
+			var id string
+			if err := json.Unmarshal(raw, &id); err != nil || id == "" {
+				return SelectValue{}, err
+			}
+			return SelectValue{Tag: &Tag{ID: id}}, nil
+		}
+		var v SelectValue
+		err = json.Unmarshal(raw, &v.Tag)
+		return v, err
+	case PropertyFormatMultiSelect:
+		if link {
+			var ids []string
+			if err := json.Unmarshal(raw, &ids); err != nil {
+				return nil, err
+			}
+			v := MultiSelectValue{}
+			for _, id := range ids {
+				v.Tags = append(v.Tags, Tag{ID: id})
+			}
+			return v, nil
+		}
+		var v MultiSelectValue
+		err = json.Unmarshal(raw, &v.Tags)
+		return v, err
+	}
+
+	return nil, nil
+}
+
+// TextProperty returns a PropertyLinkValue setting a text property
+func TextProperty(key, text string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: TextValue{Text: text}}
+}
+
+// NumberProperty returns a PropertyLinkValue setting a number property
+func NumberProperty(key string, number float64) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: NumberValue{Number: number}}
+}
+
+// SelectProperty returns a PropertyLinkValue selecting a tag by ID
+func SelectProperty(key, tagID string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: SelectValue{Tag: &Tag{ID: tagID}}}
+}
+
+// MultiSelectProperty returns a PropertyLinkValue selecting tags by ID
+func MultiSelectProperty(key string, tagIDs ...string) PropertyLinkValue {
+	v := MultiSelectValue{Tags: make([]Tag, 0, len(tagIDs))}
+	for _, id := range tagIDs {
+		v.Tags = append(v.Tags, Tag{ID: id})
+	}
+	return PropertyLinkValue{Key: key, Value: v}
+}
+
+// DateProperty returns a PropertyLinkValue setting a date property
+func DateProperty(key string, date time.Time) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: DateValue{Date: date}}
+}
+
+// FilesProperty returns a PropertyLinkValue setting a files property
+func FilesProperty(key string, fileIDs ...string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: FilesValue{Files: fileIDs}}
+}
+
+// CheckboxProperty returns a PropertyLinkValue setting a checkbox property
+func CheckboxProperty(key string, checked bool) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: CheckboxValue{Checkbox: checked}}
+}
+
+// URLProperty returns a PropertyLinkValue setting a url property
+func URLProperty(key, url string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: URLValue{URL: url}}
+}
+
+// EmailProperty returns a PropertyLinkValue setting an email property
+func EmailProperty(key, email string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: EmailValue{Email: email}}
+}
+
+// PhoneProperty returns a PropertyLinkValue setting a phone property
+func PhoneProperty(key, phone string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: PhoneValue{Phone: phone}}
+}
+
+// ObjectsProperty returns a PropertyLinkValue linking objects by ID
+func ObjectsProperty(key string, objectIDs ...string) PropertyLinkValue {
+	return PropertyLinkValue{Key: key, Value: ObjectsValue{Objects: objectIDs}}
+}
@@ -0,0 +1,88 @@
+package anytype
+
+import (
+	"sort"
+	"strings"
+)
+
+// ApplyView returns the objects matching all the filters of the view, ordered
+// by its sorts. Objects are not fetched, so a view can be reproduced over
+// cached or exported objects, provided they carry the filtered properties.
+func ApplyView(view ListView, objs []Object) []Object {
+	matches := []Object{}
+	for _, obj := range objs {
+		if matchFilters(view.Filters, obj) {
+			matches = append(matches, obj)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		for _, s := range view.Sorts {
+			if cmp := CompareObjects(s, matches[i], matches[j]); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	return matches
+}
+
+// matchFilters reports whether the object matches all filters
+func matchFilters(filters []ListFilter, obj Object) bool {
+	for _, filter := range filters {
+		if !MatchFilter(filter, obj) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareObjects compares two objects by a list view sort, returning a
+// negative number when a sorts before b, a positive number when it sorts
+// after, and 0 otherwise. Objects without a value sort last in both
+// directions; custom sorts, which depend on an order kept by the server,
+// compare every object as equal.
+func CompareObjects(s ListSort, a, b Object) int {
+	var direction int
+	switch SortDirection(s.SortType) {
+	case SortDirectionAsc:
+		direction = 1
+	case SortDirectionDesc:
+		direction = -1
+	default:
+		return 0
+	}
+
+	keyA := lookupOperand(a, s.PropertyKey).sortKey()
+	keyB := lookupOperand(b, s.PropertyKey).sortKey()
+	switch {
+	case keyA == nil && keyB == nil:
+		return 0
+	case keyA == nil:
+		return 1
+	case keyB == nil:
+		return -1
+	}
+
+	if x, ok := keyA.(string); ok {
+		keyA = strings.ToLower(x)
+	}
+	if y, ok := keyB.(string); ok {
+		keyB = strings.ToLower(y)
+	}
+	cmp, _ := compareScalar(keyA, keyB)
+	return direction * cmp
+}
+
+// sortKey returns the value the operand sorts by, nil when empty. Lists sort
+// by the displayed alias of their first item.
+func (op operand) sortKey() any {
+	if op.empty() {
+		return nil
+	}
+	if op.list {
+		item := op.listItems[0]
+		return item[len(item)-1]
+	}
+	return op.scalar
+}